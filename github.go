@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/brotherlogic/goserver"
@@ -35,25 +36,135 @@ type GithubBridge struct {
 	accessCode string
 	serving    bool
 	getter     httpGetter
+	cards      cardClient
 	attempts   int
 	fails      int
 	added      map[string]time.Time
 	issues     []*pbgh.Issue
+	watchers   map[int]*watcher
+	watched    map[string]*pbgh.Issue
+	watchID    int
+	watchMutex *sync.Mutex
+
+	webhookSecret string
+	deliveries    map[string]*pbgh.WebhookDelivery
+	webhooks      int
+	webhookMutex  *sync.Mutex
+	webhookLog    *pbgh.WebhookLog
+
+	mirror      *pbgh.IssueMirror
+	mirrorMutex *sync.Mutex
+	scoring     *pbgh.ScoringConfig
+	rescore     bool
+	lastRescore time.Time
+	channels    *pbgh.ChannelConfig
+	builds      *pbgh.BuildConfig
+	stale       *pbgh.StaleConfig
+	slas        *pbgh.SLAConfig
+	filing      *pbgh.FilingConfig
+
+	notifications *pbgh.NotificationState
+
+	tracked      *pbgh.TrackedIssues
+	trackedMutex *sync.Mutex
+
+	redactions  map[string]int
+	redactMutex *sync.Mutex
+	gists       int
+	attachments *pbgh.AttachmentIndex
+	attachMutex *sync.Mutex
+
+	auditLog   *pbgh.AuditLog
+	auditMutex *sync.Mutex
+	auth       *pbgh.AuthPolicy
+	admin      string
+
+	quotas     *pbgh.QuotaConfig
+	buckets    map[string]*bucket
+	suppressed map[filer]*suppression
+	lastFiled  map[filer]int32
+	quotaMutex *sync.Mutex
+
+	circuit      *breaker
+	circuitMutex *sync.Mutex
+
+	cardsAdded   int
+	cardsUpdated int
+	cardsDeleted int
+}
+
+type cardClient interface {
+	GetCards(ctx context.Context, in *pb.Empty) (*pb.CardList, error)
+	AddCards(ctx context.Context, in *pb.CardList) (*pb.CardList, error)
+	DeleteCards(ctx context.Context, in *pb.DeleteRequest) (*pb.CardList, error)
+}
+
+type prodCardClient struct {
+	getIP func(string) (string, int)
+}
+
+func (c prodCardClient) dial() (*grpc.ClientConn, pb.CardServiceClient, error) {
+	ip, port := c.getIP("cardserver")
+	conn, err := grpc.Dial(ip+":"+strconv.Itoa(port), grpc.WithInsecure())
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, pb.NewCardServiceClient(conn), nil
+}
+
+func (c prodCardClient) GetCards(ctx context.Context, in *pb.Empty) (*pb.CardList, error) {
+	conn, client, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return client.GetCards(ctx, in)
+}
+
+func (c prodCardClient) AddCards(ctx context.Context, in *pb.CardList) (*pb.CardList, error) {
+	conn, client, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return client.AddCards(ctx, in)
+}
+
+func (c prodCardClient) DeleteCards(ctx context.Context, in *pb.DeleteRequest) (*pb.CardList, error) {
+	conn, client, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return client.DeleteCards(ctx, in)
 }
 
 type httpGetter interface {
 	Post(url string, data string) (*http.Response, error)
+	Patch(url string, data string) (*http.Response, error)
 	Get(url string) (*http.Response, error)
 }
 
 type prodHTTPGetter struct{}
 
+// Calls which hang count against the circuit breaker
+var githubClient = &http.Client{Timeout: time.Second * 30}
+
 func (httpGetter prodHTTPGetter) Post(url string, data string) (*http.Response, error) {
-	return http.Post(url, "application/json", bytes.NewBuffer([]byte(data)))
+	return githubClient.Post(url, "application/json", bytes.NewBuffer([]byte(data)))
+}
+
+func (httpGetter prodHTTPGetter) Patch(url string, data string) (*http.Response, error) {
+	req, err := http.NewRequest("PATCH", url, bytes.NewBuffer([]byte(data)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return githubClient.Do(req)
 }
 
 func (httpGetter prodHTTPGetter) Get(url string) (*http.Response, error) {
-	return http.Get(url)
+	return githubClient.Get(url)
 }
 
 //Init a record getter
@@ -65,7 +176,49 @@ func Init() *GithubBridge {
 		attempts: 0,
 		fails:    0,
 		added:    make(map[string]time.Time),
+
+		watchers:   make(map[int]*watcher),
+		watched:    make(map[string]*pbgh.Issue),
+		watchMutex: &sync.Mutex{},
+
+		deliveries:   make(map[string]*pbgh.WebhookDelivery),
+		webhookMutex: &sync.Mutex{},
+		webhookLog:   &pbgh.WebhookLog{},
+
+		mirror:      &pbgh.IssueMirror{},
+		mirrorMutex: &sync.Mutex{},
+		scoring:     defaultScoring(),
+		channels:    &pbgh.ChannelConfig{},
+		builds:      &pbgh.BuildConfig{},
+		stale:       &pbgh.StaleConfig{},
+		slas:        &pbgh.SLAConfig{},
+		filing:      &pbgh.FilingConfig{},
+
+		notifications: &pbgh.NotificationState{},
+
+		tracked:      &pbgh.TrackedIssues{},
+		trackedMutex: &sync.Mutex{},
+
+		redactions:  make(map[string]int),
+		redactMutex: &sync.Mutex{},
+		attachments: &pbgh.AttachmentIndex{},
+		attachMutex: &sync.Mutex{},
+
+		auditLog:   &pbgh.AuditLog{},
+		auditMutex: &sync.Mutex{},
+		auth:       &pbgh.AuthPolicy{},
+		admin:      "admin",
+
+		quotas:     &pbgh.QuotaConfig{},
+		buckets:    make(map[string]*bucket),
+		suppressed: make(map[filer]*suppression),
+		lastFiled:  make(map[filer]int32),
+		quotaMutex: &sync.Mutex{},
+
+		circuit:      &breaker{state: breakerClosed},
+		circuitMutex: &sync.Mutex{},
 	}
+	s.cards = prodCardClient{getIP: s.GetIP}
 	s.Register = s
 	return s
 }
@@ -77,43 +230,152 @@ func (b *GithubBridge) DoRegister(server *grpc.Server) {
 
 // ReportHealth alerts if we're not healthy
 func (b GithubBridge) ReportHealth() bool {
-	return true
+	return !b.circuitOpen()
 }
 
 func (b *GithubBridge) saveIssues(ctx context.Context) {
+	queueDepth.Set(float64(len(b.issues)))
 	b.KSclient.Save(ctx, KEY, &pbgh.IssueList{Issues: b.issues})
 }
 
-func (b GithubBridge) readIssues(ctx context.Context) error {
+func (b *GithubBridge) readIssues(ctx context.Context) error {
 	issues := &pbgh.IssueList{}
 	data, _, err := b.KSclient.Read(ctx, KEY, issues)
 	if err != nil {
 		return err
 	}
 	b.issues = (data.(*pbgh.IssueList).Issues)
+	queueDepth.Set(float64(len(b.issues)))
 	return nil
 }
 
 // Mote promotes this server
-func (b GithubBridge) Mote(ctx context.Context, master bool) error {
+func (b *GithubBridge) Mote(ctx context.Context, master bool) error {
 	if master {
-		return b.readIssues(ctx)
+		err := b.readIssues(ctx)
+		if err != nil {
+			return err
+		}
+
+		// A fresh install has no deliveries yet
+		err = b.readWebhookLog(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read webhook log: %v", err))
+		}
+
+		// A missing mirror just means we do a full sync
+		err = b.readMirror(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read mirror: %v", err))
+		}
+
+		// Without stored rules we fall back to the defaults
+		err = b.readScoring(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read scoring rules: %v", err))
+		}
+
+		err = b.readChannels(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read channel rules: %v", err))
+		}
+
+		err = b.readBuilds(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read watched builds: %v", err))
+		}
+
+		err = b.readStale(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read stale policies: %v", err))
+		}
+
+		err = b.readSLAs(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read slas: %v", err))
+		}
+
+		err = b.readFiling(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read filing config: %v", err))
+		}
+
+		err = b.readAuth(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read auth policy: %v", err))
+		}
+
+		err = b.readQuotas(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read quotas: %v", err))
+		}
+
+		err = b.readQuotaState(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read quota state: %v", err))
+		}
+
+		err = b.readAudit(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read audit log: %v", err))
+		}
+
+		err = b.readAttachments(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read attachment index: %v", err))
+		}
+
+		err = b.readTracked(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read tracked issues: %v", err))
+		}
+
+		err = b.readNotifications(ctx)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to read notification state: %v", err))
+		}
+
+		// Catching up needs github and the cardserver, neither of which should hold up promotion
+		go func() {
+			err := b.reconcile(context.Background())
+			if err != nil {
+				b.Log(fmt.Sprintf("Unable to reconcile: %v", err))
+			}
+		}()
 	}
 	return nil
 }
 
 // GetState gets the state of the server
 func (b GithubBridge) GetState() []*pbgs.State {
+	circuit, failures := b.circuitState()
 	return []*pbgs.State{
 		&pbgs.State{Key: "attempts", Value: int64(b.attempts)},
 		&pbgs.State{Key: "fails", Value: int64(b.fails)},
 		&pbgs.State{Key: "added", Text: fmt.Sprintf("%v", b.added)},
 		&pbgs.State{Key: "sticky", Value: int64(len(b.issues))},
+		&pbgs.State{Key: "webhooks", Value: int64(b.webhookCount())},
+		&pbgs.State{Key: "webhook_log", Value: int64(len(b.webhookLog.GetDeliveries()))},
+		&pbgs.State{Key: "mirrored", Value: int64(len(b.mirror.GetIssues()))},
+		&pbgs.State{Key: "last_sync", Value: b.mirror.GetLastSync()},
+		&pbgs.State{Key: "cards_added", Value: int64(b.cardsAdded)},
+		&pbgs.State{Key: "cards_updated", Value: int64(b.cardsUpdated)},
+		&pbgs.State{Key: "cards_deleted", Value: int64(b.cardsDeleted)},
+		&pbgs.State{Key: "notifications", Value: int64(len(b.notifications.GetThreads()))},
+		&pbgs.State{Key: "tracked", Value: int64(len(b.tracked.GetIssues()))},
+		&pbgs.State{Key: "redactions", Text: fmt.Sprintf("%v", b.redactions)},
+		&pbgs.State{Key: "gists", Value: int64(b.gists)},
+		&pbgs.State{Key: "audit_events", Value: int64(len(b.auditLog.GetEvents()))},
+		&pbgs.State{Key: "suppressed", Value: int64(b.suppressedCount())},
+		&pbgs.State{Key: "circuit", Text: circuit},
+		&pbgs.State{Key: "circuit_failures", Value: int64(failures)},
 	}
 }
 
 const (
 	wait = 5 * time.Minute // Wait five minute between runs
+
+	rescoreInterval = 24 * time.Hour // Refresh every card's priority daily so age keeps counting
 )
 
 func (b *GithubBridge) postURL(urlv string, data string) (*http.Response, error) {
@@ -124,11 +386,41 @@ func (b *GithubBridge) postURL(urlv string, data string) (*http.Response, error)
 		url = url + "?access_token=" + b.accessCode
 	}
 
-	return b.getter.Post(url, data)
+	start := time.Now()
+	if err := b.allowRequest(start); err != nil {
+		return nil, err
+	}
+	resp, err := b.getter.Post(url, data)
+	recordRequest("POST", urlv, start, resp, err)
+	b.recordResult(resp, err, time.Now())
+	return resp, err
+}
+
+func (b *GithubBridge) patchURL(urlv string, data string) (*http.Response, error) {
+	url := urlv
+	if len(b.accessCode) > 0 && strings.Contains(urlv, "?") {
+		url = url + "&access_token=" + b.accessCode
+	} else {
+		url = url + "?access_token=" + b.accessCode
+	}
+
+	start := time.Now()
+	if err := b.allowRequest(start); err != nil {
+		return nil, err
+	}
+	resp, err := b.getter.Patch(url, data)
+	recordRequest("PATCH", urlv, start, resp, err)
+	b.recordResult(resp, err, time.Now())
+	return resp, err
 }
 
 func (b *GithubBridge) visitURL(urlv string) (string, error) {
+	body, _, err := b.visitPage(urlv)
+	return body, err
+}
 
+// visitPage gets a page from github along with the url of the next page, if there is one
+func (b *GithubBridge) visitPage(urlv string) (string, string, error) {
 	url := urlv
 	if len(b.accessCode) > 0 && strings.Contains(urlv, "?") {
 		url = url + "&access_token=" + b.accessCode
@@ -137,19 +429,25 @@ func (b *GithubBridge) visitURL(urlv string) (string, error) {
 	}
 
 	b.Log(fmt.Sprintf("VISIT %v", url))
+	start := time.Now()
+	if err := b.allowRequest(start); err != nil {
+		return "", "", err
+	}
 	resp, err := b.getter.Get(url)
+	recordRequest("GET", urlv, start, resp, err)
+	b.recordResult(resp, err, time.Now())
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
-	return string(body), nil
+	return string(body), nextPage(resp.Header.Get("Link")), nil
 }
 
 // Project is a project in the github world
@@ -185,23 +483,37 @@ func (b *GithubBridge) issueExists(title string) (*pbgh.Issue, error) {
 
 // Payload for sending to github
 type Payload struct {
-	Title    string `json:"title"`
-	Body     string `json:"body"`
-	Assignee string `json:"assignee"`
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	Assignee  string   `json:"assignee,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
 }
 
+// errIssueExists is returned when there's already an open issue with the same title
+var errIssueExists = errors.New("Issue already exists")
+
 // AddIssueLocal adds an issue
-func (b *GithubBridge) AddIssueLocal(owner, repo, title, body string) ([]byte, error) {
+func (b *GithubBridge) AddIssueLocal(ctx context.Context, owner, repo, title, body string) ([]byte, error) {
+	return b.addPayload(ctx, owner, repo, Payload{Title: title, Body: body, Assignee: owner})
+}
+
+// addPayload files an issue from a fully formed payload
+func (b *GithubBridge) addPayload(ctx context.Context, owner, repo string, payload Payload) ([]byte, error) {
 	b.attempts++
-	issue, err := b.issueExists(title)
+	payload.Title = b.redactFor(repo, payload.Title)
+
+	issue, err := b.issueExists(payload.Title)
 	if err != nil {
 		return nil, err
 	}
 	if issue != nil {
-		return nil, errors.New("Issue already exists")
+		return nil, errIssueExists
 	}
 
-	payload := Payload{Title: title, Body: body, Assignee: owner}
+	// Only upload an oversized body once we know it's going to be filed
+	payload.Body = b.shrinkBody(ctx, payload.Title, b.redactFor(repo, payload.Body))
+
 	bytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
@@ -221,6 +533,7 @@ func (b *GithubBridge) AddIssueLocal(owner, repo, title, body string) ([]byte, e
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
 		b.fails++
 		b.Log(fmt.Sprintf("%v returned from github: %v -> %v", resp.StatusCode, string(rb), string(bytes)))
+		return nil, &githubError{code: resp.StatusCode, body: string(rb)}
 	}
 
 	return rb, nil
@@ -232,77 +545,125 @@ func hash(s string) int32 {
 	return int32(h.Sum32())
 }
 
-// GetIssueLocal Gets github issues for a given project
-func (b *GithubBridge) GetIssueLocal(owner string, project string, number int) (*pbgh.Issue, error) {
-	urlv := "https://api.github.com/repos/" + owner + "/" + project + "/issues/" + strconv.Itoa(number)
-	body, err := b.visitURL(urlv)
-
-	if err != nil {
-		return nil, err
+// parseTime reads a github timestamp
+func parseTime(data map[string]interface{}, key string) int64 {
+	if str, ok := data[key].(string); ok {
+		date, err := time.Parse("2006-01-02T15:04:05Z", str)
+		if err != nil {
+			log.Printf("Error reading dates: %v", err)
+			return 0
+		}
+		return date.Unix()
 	}
+	return 0
+}
 
-	var data map[string]interface{}
-	err = json.Unmarshal([]byte(body), &data)
-	if err != nil {
-		return nil, err
+// issueFromJSON builds an issue from the github representation
+func issueFromJSON(service string, data map[string]interface{}) (*pbgh.Issue, error) {
+	title, ok := data["title"].(string)
+	if !ok {
+		return nil, fmt.Errorf("Issue has no title: %v", data)
+	}
+	state, ok := data["state"].(string)
+	if !ok {
+		return nil, fmt.Errorf("Issue has no state: %v", data)
 	}
 
-	log.Printf("HERE: (%v %v) %v", data["state"].(string), data["state"].(string) == "open", data)
-	issue := &pbgh.Issue{Number: int32(number), Service: project, Title: data["title"].(string), Body: data["body"].(string)}
-	if data["state"].(string) == "open" {
+	issue := &pbgh.Issue{Service: service, Title: title}
+	if len(service) == 0 {
+		if repo, ok := data["repository_url"].(string); ok {
+			issue.Service = repo[strings.LastIndex(repo, "/")+1:]
+		}
+	}
+	if url, ok := data["url"].(string); ok {
+		issue.Url = url
+	}
+	issue.CreatedAt = parseTime(data, "created_at")
+	issue.UpdatedAt = parseTime(data, "updated_at")
+	if number, ok := data["number"].(float64); ok {
+		issue.Number = int32(number)
+	}
+	if body, ok := data["body"].(string); ok {
+		issue.Body = body
+	}
+	if state == "open" {
 		issue.State = pbgh.Issue_OPEN
 	} else {
 		issue.State = pbgh.Issue_CLOSED
 	}
-
-	log.Printf("ISSUE = %v", issue)
+	if comments, ok := data["comments"].(float64); ok {
+		issue.Comments = int32(comments)
+	}
+	if labels, ok := data["labels"].([]interface{}); ok {
+		for _, label := range labels {
+			if l, ok := label.(map[string]interface{}); ok {
+				if name, ok := l["name"].(string); ok {
+					issue.Labels = append(issue.Labels, name)
+				}
+			}
+		}
+	}
+	if assignee, ok := data["assignee"].(map[string]interface{}); ok {
+		issue.Assignee, _ = assignee["login"].(string)
+	}
+	if reactions, ok := data["reactions"].(map[string]interface{}); ok {
+		if count, ok := reactions["total_count"].(float64); ok {
+			issue.Reactions = int32(count)
+		}
+	}
 	return issue, nil
 }
 
-// GetIssues Gets github issues for a given project
-func (b *GithubBridge) GetIssues() pb.CardList {
-	cardlist := pb.CardList{}
-	urlv := "https://api.github.com/issues?state=open&filter=all"
+// GetIssueLocal Gets github issues for a given project
+func (b *GithubBridge) GetIssueLocal(owner string, project string, number int) (*pbgh.Issue, error) {
+	urlv := "https://api.github.com/repos/" + owner + "/" + project + "/issues/" + strconv.Itoa(number)
 	body, err := b.visitURL(urlv)
 
 	if err != nil {
-		return cardlist
+		return nil, err
 	}
 
-	var data []interface{}
+	var data map[string]interface{}
 	err = json.Unmarshal([]byte(body), &data)
 	if err != nil {
-		return cardlist
+		return nil, err
 	}
 
-	for _, issue := range data {
-		issueMap := issue.(map[string]interface{})
-
-		if _, ok := issueMap["pull_request"]; !ok {
-			issueSource := issueMap["url"].(string)
-			issueTitle := issueMap["title"].(string)
-			issueText := issueMap["body"].(string)
+	log.Printf("HERE: %v", data)
+	issue, err := issueFromJSON(project, data)
+	log.Printf("ISSUE = %v", issue)
+	return issue, err
+}
 
-			date, err := time.Parse("2006-01-02T15:04:05Z", issueMap["created_at"].(string))
+// issueCard builds the card for a github issue
+func (b *GithubBridge) issueCard(issue *pbgh.Issue) *pb.Card {
+	card := &pb.Card{}
+	card.Text = issue.GetTitle() + "\n" + issue.GetBody() + "\n\n" + issue.GetUrl()
+	card.Hash = "githubissue-" + issue.GetUrl()
+	card.Channel = b.channelFor(issue)
+	card.Priority = b.scoreIssue(issue, time.Now()).GetTotal()
+	b.escalateCard(issue, card, time.Now())
+	return card
+}
 
-			if err != nil {
-				log.Printf("Error reading dates: %v", err)
-			}
+// GetIssues Gets the cards for all the open issues we know about
+func (b *GithubBridge) GetIssues() pb.CardList {
+	b.mirrorMutex.Lock()
+	defer b.mirrorMutex.Unlock()
 
-			card := &pb.Card{}
-			card.Text = issueTitle + "\n" + issueText + "\n\n" + issueSource
-			card.Hash = "githubissue-" + issueSource
-			card.Channel = pb.Card_ISSUES
-			card.Priority = int32(time.Now().Sub(date).Seconds())
-			cardlist.Cards = append(cardlist.Cards, card)
+	cardlist := pb.CardList{}
+	for _, issue := range b.mirror.GetIssues() {
+		if b.mirror.GetSnoozed()[issue.GetUrl()] > time.Now().Unix() {
+			continue
 		}
+		cardlist.Cards = append(cardlist.Cards, b.issueCard(issue))
 	}
 
 	return cardlist
 }
 
 // RunPass runs a pass over
-func (b GithubBridge) RunPass(ctx context.Context) {
+func (b *GithubBridge) RunPass(ctx context.Context) {
 	for b.serving {
 		time.Sleep(wait)
 		if b.GoServer.Registry.Master {
@@ -316,47 +677,73 @@ func (b GithubBridge) RunPass(ctx context.Context) {
 	log.Printf("Ducking out of serving")
 }
 
-func (b GithubBridge) passover() error {
+func (b *GithubBridge) passover() error {
+	if b.circuitOpen() {
+		log.Printf("Skipping passover, github is down")
+		return nil
+	}
+
 	log.Printf("RUNNING PASSOVER")
-	ip, port := b.GetIP("cardserver")
-	conn, err := grpc.Dial(ip+":"+strconv.Itoa(port), grpc.WithInsecure())
+	client := b.cards
+	cards, err := client.GetCards(context.Background(), &pb.Empty{})
 	if err != nil {
-		log.Printf("Error here: %v", err)
+		log.Printf("Error here: %v", (err))
 		return err
 	}
-	defer conn.Close()
-	client := pb.NewCardServiceClient(conn)
-	cards, err := client.GetCards(context.Background(), &pb.Empty{})
+
+	err = b.processCardIssues(context.Background(), cards.GetCards())
 	if err != nil {
-		log.Printf("Error here: %v", (err))
 		return err
 	}
 
-	for _, card := range cards.Cards {
-		if strings.HasPrefix(card.Hash, "addgithubissue") {
-			b.AddIssueLocal("brotherlogic", strings.Split(card.Hash, "-")[2], strings.Split(card.Text, "|")[0], strings.Split(card.Text, "|")[1])
-		}
+	err = b.processCardActions(context.Background(), cards.GetCards())
+	if err != nil {
+		return err
 	}
 
-	_, err = client.DeleteCards(context.Background(), &pb.DeleteRequest{HashPrefix: "addgithubissue"})
+	err = b.cleanErrorCards(context.Background(), cards.GetCards())
 	if err != nil {
 		return err
 	}
 
 	log.Printf("Doing project call")
+	changed, err := b.syncIssues(context.Background())
+	if err != nil {
+		return err
+	}
+	log.Printf("Synced %v changed issues", len(changed))
+
+	// Changed issues get their priority refreshed
+	forced := make(map[string]bool)
+	for _, issue := range changed {
+		forced[b.issueCard(issue).Hash] = true
+	}
+
 	issues := b.GetIssues()
+	if b.rescore || time.Now().Sub(b.lastRescore) >= rescoreInterval {
+		for _, card := range issues.Cards {
+			forced[card.Hash] = true
+		}
+		b.rescore = false
+		b.lastRescore = time.Now()
+	}
+	diff := diffCards("githubissue-", cards.GetCards(), issues.Cards, forced)
+	err = b.applyDiff(context.Background(), diff)
+	if err != nil {
+		return err
+	}
 
-	_, err = client.DeleteCards(context.Background(), &pb.DeleteRequest{HashPrefix: "githubissue"})
+	err = b.updatePullCards(context.Background(), cards.GetCards())
 	if err != nil {
-		log.Printf("Error deleting cards")
+		return err
 	}
-	_, err = client.AddCards(context.Background(), &issues)
+
+	err = b.updateNotificationCards(context.Background(), cards.GetCards())
 	if err != nil {
-		log.Printf("Problem adding cards %v", err)
-	} else {
-		log.Printf("Would write: %v", issues)
+		return err
 	}
 
+	lastPass.SetToCurrentTime()
 	return nil
 }
 
@@ -371,10 +758,14 @@ func (b *GithubBridge) cleanAdded(ctx context.Context) {
 func main() {
 	var quiet = flag.Bool("quiet", true, "Show all output")
 	var token = flag.String("token", "", "The token to use to auth")
+	var secret = flag.String("secret", "", "The secret used to sign github webhooks")
+	var webhookPort = flag.Int("webhook", 50081, "The port to accept github webhooks and serve metrics on")
+	var admin = flag.String("admin", "admin", "The certificate name allowed to change the auth policy")
 	flag.Parse()
 
 	b := Init()
 	b.GoServer.KSclient = *keystoreclient.GetClient(b.GetIP)
+	b.admin = *admin
 
 	//Turn off logging
 	if *quiet {
@@ -385,6 +776,10 @@ func main() {
 	b.PrepServer()
 	b.RegisterServer("githubcard", false)
 
+	if len(*secret) > 0 {
+		b.Save(context.Background(), SECRETKEY, &pbgh.Token{Token: *secret})
+	}
+
 	if len(*token) > 0 {
 		b.Save(context.Background(), "/github.com/brotherlogic/githubcard/token", &pbgh.Token{Token: *token})
 	} else {
@@ -397,6 +792,24 @@ func main() {
 			b.RegisterServingTask(b.RunPass)
 			b.RegisterRepeatingTask(b.cleanAdded, "clean_added", time.Minute)
 			b.RegisterRepeatingTask(b.procSticky, "proc_sticky", time.Minute*5)
+			b.RegisterRepeatingTask(b.checkWatched, "check_watched", time.Minute)
+			b.RegisterRepeatingTask(b.cleanDeliveries, "clean_deliveries", time.Hour)
+			b.RegisterRepeatingTask(b.checkBuilds, "check_builds", time.Minute*5)
+			b.RegisterRepeatingTask(b.applyStale, "apply_stale", time.Hour)
+			b.RegisterRepeatingTask(b.checkSLAs, "check_slas", time.Minute*15)
+			b.RegisterRepeatingTask(b.closeResolved, "close_resolved", time.Minute)
+			b.RegisterRepeatingTask(b.cleanAudit, "clean_audit", time.Hour*24)
+			b.RegisterRepeatingTask(b.flushSuppressed, "flush_suppressed", time.Minute)
+			b.RegisterRepeatingTask(b.probeGithub, "probe_github", breakerCooldown)
+
+			s, _, err := b.Read(context.Background(), SECRETKEY, &pbgh.Token{})
+			if err != nil {
+				log.Printf("Failed to read webhook secret: %v", err)
+			} else {
+				b.webhookSecret = s.(*pbgh.Token).GetToken()
+			}
+			go b.serveWebhooks(*webhookPort)
+
 			b.Serve()
 		}
 	}
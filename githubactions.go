@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// Cards carrying something to do to an issue
+	cardActionPrefix = "githubaction-"
+)
+
+var issueURL = regexp.MustCompile("^https://api.github.com/repos/([^/]+)/([^/]+)/issues/([0-9]+)$")
+
+// cardAction is the response carried in the text of a githubaction card
+type cardAction struct {
+	Action string   `json:"action"`
+	Issue  string   `json:"issue"`
+	Text   string   `json:"text"`
+	Labels []string `json:"labels"`
+	Until  int64    `json:"until"`
+}
+
+func parseCardAction(card *pb.Card) (*cardAction, error) {
+	action := &cardAction{}
+	err := json.Unmarshal([]byte(card.Text), action)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse card: %v", err)
+	}
+
+	if !issueURL.MatchString(action.Issue) {
+		return nil, fmt.Errorf("Bad issue url: %v", action.Issue)
+	}
+
+	switch action.Action {
+	case "close":
+	case "comment":
+		if len(action.Text) == 0 {
+			return nil, errors.New("Comment has no text")
+		}
+	case "label":
+		if len(action.Labels) == 0 {
+			return nil, errors.New("No labels to add")
+		}
+	case "snooze":
+		if action.Until <= time.Now().Unix() {
+			return nil, fmt.Errorf("Snooze time %v has passed", action.Until)
+		}
+	default:
+		return nil, fmt.Errorf("Unknown action %v", action.Action)
+	}
+
+	return action, nil
+}
+
+// githubError is a request github turned down
+type githubError struct {
+	code int
+	body string
+}
+
+func (e *githubError) Error() string {
+	return fmt.Sprintf("%v returned from github: %v", e.code, e.body)
+}
+
+// retryable reports if a failed call might go through later, github being
+// down or rate limiting us rather than refusing the request outright
+func retryable(err error) bool {
+	if gerr, ok := err.(*githubError); ok {
+		return gerr.code >= 500 || gerr.code == http.StatusForbidden || gerr.code == http.StatusTooManyRequests
+	}
+	return err != errIssueExists
+}
+
+// checkResponse turns a failed github call into an error
+func checkResponse(resp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return &githubError{code: resp.StatusCode, body: string(body)}
+	}
+	return nil
+}
+
+// runAction performs the action against github
+func (b *GithubBridge) runAction(action *cardAction) error {
+	switch action.Action {
+	case "close":
+		return checkResponse(b.patchURL(action.Issue, "{\"state\": \"closed\"}"))
+	case "comment":
+		repo := ""
+		if parts := issueURL.FindStringSubmatch(action.Issue); parts != nil {
+			repo = parts[2]
+		}
+		data, _ := json.Marshal(map[string]string{"body": b.redactFor(repo, action.Text)})
+		return checkResponse(b.postURL(action.Issue+"/comments", string(data)))
+	case "label":
+		data, _ := json.Marshal(map[string][]string{"labels": action.Labels})
+		return checkResponse(b.postURL(action.Issue+"/labels", string(data)))
+	case "snooze":
+		b.mirrorMutex.Lock()
+		if b.mirror.Snoozed == nil {
+			b.mirror.Snoozed = make(map[string]int64)
+		}
+		b.mirror.Snoozed[action.Issue] = action.Until
+		b.mirrorMutex.Unlock()
+	}
+	return nil
+}
+
+// refreshCard brings the card for an issue up to date after an action
+func (b *GithubBridge) refreshCard(ctx context.Context, action *cardAction) error {
+	parts := issueURL.FindStringSubmatch(action.Issue)
+	number, _ := strconv.Atoi(parts[3])
+	issue, err := b.GetIssueLocal(parts[1], parts[2], number)
+	if err != nil {
+		return err
+	}
+	if len(issue.GetUrl()) == 0 {
+		issue.Url = action.Issue
+	}
+
+	b.mirrorIssue(issue)
+	b.saveMirror(ctx)
+	b.updateWatched(issue)
+
+	card := b.issueCard(issue)
+	_, err = b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: card.Hash})
+	if err != nil {
+		return err
+	}
+
+	if issue.GetState() == pbgh.Issue_OPEN && b.mirror.GetSnoozed()[issue.GetUrl()] <= time.Now().Unix() {
+		_, err = b.cards.AddCards(ctx, &pb.CardList{Cards: []*pb.Card{card}})
+	}
+	return err
+}
+
+// processCardActions runs all the githubaction cards, removing those that
+// made it to github and raising an error card for those that didn't
+func (b *GithubBridge) processCardActions(ctx context.Context, cards []*pb.Card) error {
+	existing := make(map[string]bool)
+	for _, card := range cards {
+		existing[card.Hash] = true
+	}
+
+	for _, card := range cards {
+		if !strings.HasPrefix(card.Hash, cardActionPrefix) {
+			continue
+		}
+
+		action, err := parseCardAction(card)
+		if err == nil {
+			err = b.runAction(action)
+			b.auditURL(ctx, "cardserver", "card_"+action.Action, action.Issue, err)
+		}
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to act on %v: %v", card.Hash, err))
+			if !existing[cardErrorPrefix+card.Hash] {
+				_, err = b.cards.AddCards(ctx, &pb.CardList{Cards: []*pb.Card{errorCard(card, err)}})
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		_, err = b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: card.Hash})
+		if err != nil {
+			return err
+		}
+
+		err = b.refreshCard(ctx, action)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to refresh card for %v: %v", action.Issue, err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+)
+
+func TestParseCardAction(t *testing.T) {
+	action, err := parseCardAction(&pbc.Card{Hash: "githubaction-1", Text: `{"action": "comment", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12", "text": "Done"}`})
+	if err != nil {
+		t.Fatalf("Unable to parse action: %v", err)
+	}
+
+	if action.Action != "comment" || action.Text != "Done" {
+		t.Errorf("Action was parsed incorrectly: %v", action)
+	}
+}
+
+func TestParseBadCardActions(t *testing.T) {
+	texts := []string{
+		`{"action": "close", "issue": "https://example.com/issues/12"}`,
+		`{"action": "comment", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12"}`,
+		`{"action": "label", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12"}`,
+		`{"action": "snooze", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12", "until": 10}`,
+		`{"action": "explode", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12"}`,
+		`Nonsense`,
+	}
+
+	for _, text := range texts {
+		action, err := parseCardAction(&pbc.Card{Hash: "githubaction-1", Text: text})
+		if err == nil {
+			t.Errorf("Bad action %v was parsed: %v", text, action)
+		}
+	}
+}
+
+func TestCloseFromCard(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{
+		&pbc.Card{Hash: "githubissue-https://api.github.com/repos/brotherlogic/home/issues/12"},
+		&pbc.Card{Hash: "githubaction-1", Text: `{"action": "close", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12"}`},
+	}}
+	s.cards = cards
+
+	err := s.processCardActions(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error processing actions: %v", err)
+	}
+
+	if len(cards.cards) != 0 {
+		t.Errorf("Cards were not removed: %v", cards.cards)
+	}
+}
+
+func TestCommentAndLabelFromCard(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{
+		&pbc.Card{Hash: "githubaction-1", Text: `{"action": "comment", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12", "text": "Done from the card"}`},
+		&pbc.Card{Hash: "githubaction-2", Text: `{"action": "label", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12", "labels": ["bug"]}`},
+	}}
+	s.cards = cards
+
+	err := s.processCardActions(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error processing actions: %v", err)
+	}
+
+	if len(cards.cards) != 0 {
+		t.Errorf("Action cards were not removed: %v", cards.cards)
+	}
+}
+
+func TestSnoozeFromCard(t *testing.T) {
+	s := InitTest()
+	_, err := s.syncIssues(context.Background())
+	if err != nil {
+		t.Fatalf("Unable to sync: %v", err)
+	}
+
+	err = s.processCardActions(context.Background(), []*pbc.Card{&pbc.Card{Hash: "githubaction-1", Text: `{"action": "snooze", "issue": "https://api.github.com/repos/brotherlogic/crasher/issues/15", "until": ` + strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10) + `}`}})
+	if err != nil {
+		t.Fatalf("Error processing actions: %v", err)
+	}
+
+	issues := s.GetIssues()
+	if len(issues.Cards) != 1 {
+		t.Errorf("Snoozed issue still has a card: %v", issues.Cards)
+	}
+}
+
+func TestFailedActionRaisesErrorCard(t *testing.T) {
+	s := InitTest()
+	s.getter = failGetter{}
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubaction-1", Text: `{"action": "close", "issue": "https://api.github.com/repos/brotherlogic/Home/issues/12"}`}}}
+	s.cards = cards
+
+	err := s.processCardActions(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error processing actions: %v", err)
+	}
+
+	if len(cards.cards) != 2 || cards.cards[1].Hash != cardErrorPrefix+"githubaction-1" {
+		t.Errorf("Error card was not raised: %v", cards.cards)
+	}
+}
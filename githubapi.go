@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	"golang.org/x/net/context"
@@ -17,8 +18,53 @@ type addResponse struct {
 
 //AddIssue adds an issue to github
 func (g *GithubBridge) AddIssue(ctx context.Context, in *pb.Issue) (*pb.Issue, error) {
+	countRPC(ctx, "AddIssue")
+	err := g.authorize(ctx, "AddIssue", in.GetService())
+	var issue *pb.Issue
+	if err == nil {
+		issue, err = g.addIssue(ctx, in)
+	}
+	g.audit(ctx, callerFor(ctx), "AddIssue", in.GetService(), in.GetNumber(), err)
+	return issue, err
+}
+
+func (g *GithubBridge) addIssue(ctx context.Context, in *pb.Issue) (*pb.Issue, error) {
+	//A problem we already have an issue for just stays open
+	if len(in.GetFingerprint()) > 0 {
+		if tracked := g.recurred(ctx, in); tracked != nil {
+			dedupHits.WithLabelValues("fingerprint").Inc()
+			in.Number = tracked.GetNumber()
+			return in, nil
+		}
+	}
+
+	err := g.checkQuota(ctx, callerFor(ctx), in, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	//Fall back to the raw issue rather than lose it
+	err = g.renderIssue(in)
+	if err != nil {
+		g.Log(fmt.Sprintf("Unable to render %v: %v", in.GetTitle(), err))
+	}
+
+	in.Caller = callerFor(ctx)
+
+	//Github is down, so queue it up to file once it's back
+	if g.circuitOpen() {
+		err = validateAttachments(in.GetAttachments())
+		if err != nil {
+			return nil, err
+		}
+		g.issues = append(g.issues, in)
+		g.saveIssues(ctx)
+		return in, nil
+	}
+
 	//Don't double add issues
 	if v, ok := g.added[in.GetTitle()]; ok {
+		dedupHits.WithLabelValues("title").Inc()
 		if !in.Sticky {
 			return nil, fmt.Errorf("Unable to add this issue - recently added (%v)", v)
 		}
@@ -27,32 +73,336 @@ func (g *GithubBridge) AddIssue(ctx context.Context, in *pb.Issue) (*pb.Issue, e
 		return in, nil
 	}
 
+	err = g.attachFiles(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
 	g.added[in.GetTitle()] = time.Now()
-	b, err := g.AddIssueLocal("brotherlogic", in.GetService(), in.GetTitle(), in.GetBody())
+	err = g.fileIssue(ctx, in)
 	if err != nil {
-		if in.Sticky {
+		if in.Sticky && retryable(err) {
 			g.issues = append(g.issues, in)
+			g.saveIssues(ctx)
 			return in, nil
 		}
 		return nil, err
 	}
+	return in, nil
+}
+
+// fileIssue sends an issue to github and keeps track of what was filed
+func (g *GithubBridge) fileIssue(ctx context.Context, in *pb.Issue) error {
 	r := &addResponse{}
-	err2 := json.Unmarshal(b, &r)
-	if err2 != nil {
-		return nil, err2
+	b, err := g.AddIssueLocal(ctx, "brotherlogic", in.GetService(), in.GetTitle(), in.GetBody())
+	if gerr, ok := err.(*githubError); ok && gerr.code == http.StatusNotFound {
+		r.Message = "Not Found"
+	} else if err != nil {
+		return err
+	} else if err = json.Unmarshal(b, &r); err != nil {
+		return err
 	}
 
 	if r.Message == "Not Found" {
-		g.AddIssue(ctx, &pb.Issue{Service: "githubcard", Title: "Add Failure", Body: fmt.Sprintf("Couldn't add issue for %v with title %v (%v)", in.Service, in.GetTitle(), in.GetBody())})
-		return nil, fmt.Errorf("Error adding issue for service %v", in.Service)
+		// This is our own report, so it skips the caller's grants and quota
+		_, ferr := g.AddIssueLocal(ctx, "brotherlogic", "githubcard", "Add Failure", fmt.Sprintf("Couldn't add issue for %v with title %v (%v)", in.Service, in.GetTitle(), in.GetBody()))
+		g.audit(ctx, selfCaller, "add_failure", "githubcard", 0, ferr)
+		return &githubError{code: http.StatusNotFound, body: fmt.Sprintf("Error adding issue for service %v", in.Service)}
 	}
 
 	in.Number = r.Number
-	return in, nil
+	if in.GetNumber() > 0 {
+		g.recordFiled(ctx, in.GetCaller(), in)
+		if len(in.GetFingerprint()) > 0 {
+			g.trackIssue(ctx, in)
+		}
+	}
+	return nil
 }
 
 //Get gets an issue from github
 func (g *GithubBridge) Get(ctx context.Context, in *pb.Issue) (*pb.Issue, error) {
+	countRPC(ctx, "Get")
+	if err := g.authorize(ctx, "Get", in.GetService()); err != nil {
+		return nil, err
+	}
 	b, err := g.GetIssueLocal("brotherlogic", in.GetService(), int(in.GetNumber()))
 	return b, err
 }
+
+//Replay re-processes webhook deliveries received since the given time
+func (g *GithubBridge) Replay(ctx context.Context, in *pb.ReplayRequest) (*pb.ReplayResponse, error) {
+	countRPC(ctx, "Replay")
+	err := g.authorize(ctx, "Replay", "")
+	g.auditTarget(ctx, callerFor(ctx), "Replay", WEBHOOKKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReplayResponse{Replayed: int32(g.replayDeliveries(ctx, in.GetSince(), false))}, nil
+}
+
+//GetScoring gets the card priority rules
+func (g *GithubBridge) GetScoring(ctx context.Context, in *pb.Empty) (*pb.ScoringConfig, error) {
+	countRPC(ctx, "GetScoring")
+	if err := g.authorize(ctx, "GetScoring", ""); err != nil {
+		return nil, err
+	}
+	return g.scoring, nil
+}
+
+//SetScoring replaces the card priority rules
+func (g *GithubBridge) SetScoring(ctx context.Context, in *pb.ScoringConfig) (*pb.ScoringConfig, error) {
+	countRPC(ctx, "SetScoring")
+	err := g.authorize(ctx, "SetScoring", "")
+	if err == nil {
+		err = g.KSclient.Save(ctx, SCORINGKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetScoring", SCORINGKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	g.scoring = in
+	g.rescore = true
+	return in, nil
+}
+
+//ExplainScore shows how the priority of an issue's card is built up
+func (g *GithubBridge) ExplainScore(ctx context.Context, in *pb.Issue) (*pb.ScoreBreakdown, error) {
+	countRPC(ctx, "ExplainScore")
+	if err := g.authorize(ctx, "ExplainScore", in.GetService()); err != nil {
+		return nil, err
+	}
+	issue, err := g.GetIssueLocal("brotherlogic", in.GetService(), int(in.GetNumber()))
+	if err != nil {
+		return nil, err
+	}
+	return g.scoreIssue(issue, time.Now()), nil
+}
+
+//GetChannels gets the rules for routing issues to card channels
+func (g *GithubBridge) GetChannels(ctx context.Context, in *pb.Empty) (*pb.ChannelConfig, error) {
+	countRPC(ctx, "GetChannels")
+	if err := g.authorize(ctx, "GetChannels", ""); err != nil {
+		return nil, err
+	}
+	return g.channels, nil
+}
+
+//SetChannels replaces the rules for routing issues to card channels
+func (g *GithubBridge) SetChannels(ctx context.Context, in *pb.ChannelConfig) (*pb.ChannelConfig, error) {
+	countRPC(ctx, "SetChannels")
+	err := g.authorize(ctx, "SetChannels", "")
+	if err == nil {
+		err = validateChannels(in)
+	}
+	if err == nil {
+		err = g.KSclient.Save(ctx, CHANNELKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetChannels", CHANNELKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	g.channels = in
+	return in, nil
+}
+
+//GetBuilds gets the repos whose default branch builds we watch
+func (g *GithubBridge) GetBuilds(ctx context.Context, in *pb.Empty) (*pb.BuildConfig, error) {
+	countRPC(ctx, "GetBuilds")
+	if err := g.authorize(ctx, "GetBuilds", ""); err != nil {
+		return nil, err
+	}
+	return g.builds, nil
+}
+
+//SetBuilds replaces the repos whose default branch builds we watch
+func (g *GithubBridge) SetBuilds(ctx context.Context, in *pb.BuildConfig) (*pb.BuildConfig, error) {
+	countRPC(ctx, "SetBuilds")
+	err := g.authorize(ctx, "SetBuilds", "")
+	for _, watch := range in.GetRepos() {
+		if err == nil && !validRepo.MatchString(watch.GetRepo()) {
+			err = fmt.Errorf("Bad repo name %v", watch.GetRepo())
+		}
+	}
+	if err == nil {
+		err = g.KSclient.Save(ctx, BUILDKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetBuilds", BUILDKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	g.builds = in
+	return in, nil
+}
+
+//GetStalePolicies gets the policies for marking and closing stale issues
+func (g *GithubBridge) GetStalePolicies(ctx context.Context, in *pb.Empty) (*pb.StaleConfig, error) {
+	countRPC(ctx, "GetStalePolicies")
+	if err := g.authorize(ctx, "GetStalePolicies", ""); err != nil {
+		return nil, err
+	}
+	return g.stale, nil
+}
+
+//SetStalePolicies replaces the policies for marking and closing stale issues
+func (g *GithubBridge) SetStalePolicies(ctx context.Context, in *pb.StaleConfig) (*pb.StaleConfig, error) {
+	countRPC(ctx, "SetStalePolicies")
+	err := g.authorize(ctx, "SetStalePolicies", "")
+	if err == nil {
+		err = validateStale(in)
+	}
+	if err == nil {
+		err = g.KSclient.Save(ctx, STALEKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetStalePolicies", STALEKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	g.stale = in
+	return in, nil
+}
+
+//GetStaleReport lists what the stale policies would do without doing it
+func (g *GithubBridge) GetStaleReport(ctx context.Context, in *pb.Empty) (*pb.StaleReport, error) {
+	countRPC(ctx, "GetStaleReport")
+	if err := g.authorize(ctx, "GetStaleReport", ""); err != nil {
+		return nil, err
+	}
+	return &pb.StaleReport{Actions: g.staleActions(time.Now())}, nil
+}
+
+//GetSLAs gets the service levels for priority labelled issues
+func (g *GithubBridge) GetSLAs(ctx context.Context, in *pb.Empty) (*pb.SLAConfig, error) {
+	countRPC(ctx, "GetSLAs")
+	if err := g.authorize(ctx, "GetSLAs", ""); err != nil {
+		return nil, err
+	}
+	return g.slas, nil
+}
+
+//SetSLAs replaces the service levels for priority labelled issues
+func (g *GithubBridge) SetSLAs(ctx context.Context, in *pb.SLAConfig) (*pb.SLAConfig, error) {
+	countRPC(ctx, "SetSLAs")
+	err := g.authorize(ctx, "SetSLAs", "")
+	if err == nil {
+		err = validateSLAs(in)
+	}
+	if err == nil {
+		err = g.KSclient.Save(ctx, SLAKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetSLAs", SLAKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	g.slas = in
+	g.rescore = true
+	return in, nil
+}
+
+//ResolveIssue tells us the problem behind a fingerprinted issue has gone away
+func (g *GithubBridge) ResolveIssue(ctx context.Context, in *pb.ResolveRequest) (*pb.ResolveResponse, error) {
+	countRPC(ctx, "ResolveIssue")
+	err := g.authorize(ctx, "ResolveIssue", in.GetService())
+	var tracked *pb.TrackedIssue
+	if err == nil {
+		tracked, err = g.resolve(ctx, in)
+	}
+	g.audit(ctx, callerFor(ctx), "ResolveIssue", tracked.GetService(), tracked.GetNumber(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResolveResponse{Issue: tracked}, nil
+}
+
+//GetFiling gets the templates and routes used when filing issues
+func (g *GithubBridge) GetFiling(ctx context.Context, in *pb.Empty) (*pb.FilingConfig, error) {
+	countRPC(ctx, "GetFiling")
+	if err := g.authorize(ctx, "GetFiling", ""); err != nil {
+		return nil, err
+	}
+	return g.filing, nil
+}
+
+//SetFiling replaces the templates and routes used when filing issues
+func (g *GithubBridge) SetFiling(ctx context.Context, in *pb.FilingConfig) (*pb.FilingConfig, error) {
+	countRPC(ctx, "SetFiling")
+	err := g.authorize(ctx, "SetFiling", "")
+	if err == nil {
+		err = validateFiling(in)
+	}
+	if err == nil {
+		err = g.KSclient.Save(ctx, FILINGKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetFiling", FILINGKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	g.filing = in
+	return in, nil
+}
+
+//ListAuditEvents lists the changes we've made on github
+func (g *GithubBridge) ListAuditEvents(ctx context.Context, in *pb.AuditRequest) (*pb.AuditResponse, error) {
+	countRPC(ctx, "ListAuditEvents")
+	if err := g.authorize(ctx, "ListAuditEvents", ""); err != nil {
+		return nil, err
+	}
+	return &pb.AuditResponse{Events: g.auditEvents(in)}, nil
+}
+
+//GetAuthPolicy gets the policy for who can do what to which repos
+func (g *GithubBridge) GetAuthPolicy(ctx context.Context, in *pb.Empty) (*pb.AuthPolicy, error) {
+	countRPC(ctx, "GetAuthPolicy")
+	if err := g.authorize(ctx, "GetAuthPolicy", ""); err != nil {
+		return nil, err
+	}
+	return g.auth, nil
+}
+
+//SetAuthPolicy replaces the policy for who can do what to which repos
+func (g *GithubBridge) SetAuthPolicy(ctx context.Context, in *pb.AuthPolicy) (*pb.AuthPolicy, error) {
+	countRPC(ctx, "SetAuthPolicy")
+	err := g.authorize(ctx, "SetAuthPolicy", "")
+	if err == nil {
+		err = g.KSclient.Save(ctx, AUTHKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetAuthPolicy", AUTHKEY, err)
+	if err != nil {
+		return nil, err
+	}
+	g.auth = in
+	return in, nil
+}
+
+//GetQuotas gets the filing quotas for services and repos
+func (g *GithubBridge) GetQuotas(ctx context.Context, in *pb.Empty) (*pb.QuotaConfig, error) {
+	countRPC(ctx, "GetQuotas")
+	if err := g.authorize(ctx, "GetQuotas", ""); err != nil {
+		return nil, err
+	}
+
+	g.quotaMutex.Lock()
+	defer g.quotaMutex.Unlock()
+	return g.quotas, nil
+}
+
+//SetQuotas replaces the filing quotas for services and repos
+func (g *GithubBridge) SetQuotas(ctx context.Context, in *pb.QuotaConfig) (*pb.QuotaConfig, error) {
+	countRPC(ctx, "SetQuotas")
+	err := g.authorize(ctx, "SetQuotas", "")
+	if err == nil {
+		err = validateQuotas(in)
+	}
+	if err == nil {
+		err = g.KSclient.Save(ctx, QUOTAKEY, in)
+	}
+	g.auditTarget(ctx, callerFor(ctx), "SetQuotas", QUOTAKEY, err)
+	if err != nil {
+		return nil, err
+	}
+
+	g.quotaMutex.Lock()
+	defer g.quotaMutex.Unlock()
+	g.quotas = in
+	return in, nil
+}
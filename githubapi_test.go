@@ -11,6 +11,8 @@ import (
 
 	"github.com/brotherlogic/keystore/client"
 
+	pbc "github.com/brotherlogic/cardserver/card"
+	pbd "github.com/brotherlogic/discovery/proto"
 	pb "github.com/brotherlogic/githubcard/proto"
 )
 
@@ -20,15 +22,57 @@ func InitTest() *GithubBridge {
 	s.accessCode = "token"
 	s.SkipLog = true
 	s.GoServer.KSclient = *keystoreclient.GetTestClient(".test")
+	s.cards = &testCardClient{}
+	s.Registry = &pbd.RegistryEntry{Master: true}
 	return s
 }
 
+type testCardClient struct {
+	cards   []*pbc.Card
+	deletes []*pbc.DeleteRequest
+	fail    bool
+}
+
+func (c *testCardClient) GetCards(ctx context.Context, in *pbc.Empty) (*pbc.CardList, error) {
+	if c.fail {
+		return nil, errors.New("Built to Fail")
+	}
+	return &pbc.CardList{Cards: c.cards}, nil
+}
+
+func (c *testCardClient) AddCards(ctx context.Context, in *pbc.CardList) (*pbc.CardList, error) {
+	if c.fail {
+		return nil, errors.New("Built to Fail")
+	}
+	c.cards = append(c.cards, in.Cards...)
+	return &pbc.CardList{Cards: c.cards}, nil
+}
+
+func (c *testCardClient) DeleteCards(ctx context.Context, in *pbc.DeleteRequest) (*pbc.CardList, error) {
+	if c.fail {
+		return nil, errors.New("Built to Fail")
+	}
+	c.deletes = append(c.deletes, in)
+	cards := []*pbc.Card{}
+	for _, card := range c.cards {
+		if (len(in.Hash) > 0 && card.Hash != in.Hash) || (len(in.HashPrefix) > 0 && !strings.HasPrefix(card.Hash, in.HashPrefix)) {
+			cards = append(cards, card)
+		}
+	}
+	c.cards = cards
+	return &pbc.CardList{Cards: c.cards}, nil
+}
+
 type failGetter struct{}
 
 func (httpGetter failGetter) Post(url string, data string) (*http.Response, error) {
 	return nil, errors.New("Built to Fail")
 }
 
+func (httpGetter failGetter) Patch(url string, data string) (*http.Response, error) {
+	return nil, errors.New("Built to Fail")
+}
+
 func (httpGetter failGetter) Get(url string) (*http.Response, error) {
 	return nil, errors.New("Built to Fail")
 }
@@ -44,19 +88,27 @@ func (httpGetter testFileGetter) Post(url string, data string) (*http.Response,
 		strippedURL = strings.Replace(strippedURL, "token", "broke", -1)
 	}
 	blah, err := os.Open("testdata" + strippedURL)
+	response.StatusCode = 200
 	if err != nil {
 		log.Printf("Error opening test file %v", err)
+		response.StatusCode = 404
 	}
 	response.Body = blah
 	return response, nil
 }
 
+func (httpGetter testFileGetter) Patch(url string, data string) (*http.Response, error) {
+	return httpGetter.Post(url, data)
+}
+
 func (httpGetter testFileGetter) Get(url string) (*http.Response, error) {
 	response := &http.Response{}
 	strippedURL := strings.Replace(strings.Replace(url[22:], "?", "_", -1), "&", "_", -1)
 	blah, err := os.Open("testdata" + strippedURL)
+	response.StatusCode = 200
 	if err != nil {
 		log.Printf("Error opening test file %v", err)
+		response.StatusCode = 404
 	}
 	response.Body = blah
 	return response, nil
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// ATTACHMENTKEY the gists holding attachments we've already uploaded
+	ATTACHMENTKEY = "/github.com/brotherlogic/githubcard/attachments"
+
+	// Limits on what a single issue can carry
+	maxAttachmentSize  = 1024 * 1024
+	maxAttachmentTotal = 5 * 1024 * 1024
+)
+
+// validateAttachments checks the attachments fit within our limits
+func validateAttachments(attachments []*pbgh.Attachment) error {
+	total := 0
+	for _, a := range attachments {
+		if len(a.GetName()) == 0 || strings.Contains(a.GetName(), "/") {
+			return fmt.Errorf("Bad attachment name %q", a.GetName())
+		}
+		if len(a.GetContent()) > maxAttachmentSize {
+			return fmt.Errorf("Attachment %v is %v bytes, the limit is %v", a.GetName(), len(a.GetContent()), maxAttachmentSize)
+		}
+		total += len(a.GetContent())
+	}
+	if total > maxAttachmentTotal {
+		return fmt.Errorf("Attachments total %v bytes, the limit is %v", total, maxAttachmentTotal)
+	}
+	return nil
+}
+
+// gistContent makes an attachment safe to store in a gist, which only holds text,
+// running text through the same redactions as the issue itself
+func (b *GithubBridge) gistContent(service string, a *pbgh.Attachment) (string, string) {
+	if (strings.HasPrefix(a.GetContentType(), "text/") || len(a.GetContentType()) == 0) && utf8.Valid(a.GetContent()) {
+		return a.GetName(), b.redactFor(service, string(a.GetContent()))
+	}
+	return a.GetName() + ".base64", base64.StdEncoding.EncodeToString(a.GetContent())
+}
+
+// uploadAttachment puts an attachment in a gist, reusing any gist with the same content
+func (b *GithubBridge) uploadAttachment(ctx context.Context, issue *pbgh.Issue, a *pbgh.Attachment) (string, error) {
+	name, content := b.gistContent(issue.GetService(), a)
+	sum := sha256.Sum256([]byte(content))
+	key := hex.EncodeToString(sum[:])
+
+	b.attachMutex.Lock()
+	url, ok := b.attachments.GetGists()[key]
+	b.attachMutex.Unlock()
+	if ok {
+		return url, nil
+	}
+
+	url, err := b.createGist(ctx, b.redactFor(issue.GetService(), issue.GetService()+": "+issue.GetTitle()), map[string]string{name: content})
+	if err != nil {
+		return "", err
+	}
+
+	b.attachMutex.Lock()
+	defer b.attachMutex.Unlock()
+	if b.attachments.Gists == nil {
+		b.attachments.Gists = make(map[string]string)
+	}
+	b.attachments.Gists[key] = url
+	b.KSclient.Save(ctx, ATTACHMENTKEY, b.attachments)
+	return url, nil
+}
+
+// attachFiles uploads the issue's attachments and links them from the body
+func (b *GithubBridge) attachFiles(ctx context.Context, issue *pbgh.Issue) error {
+	if len(issue.GetAttachments()) == 0 {
+		return nil
+	}
+
+	err := validateAttachments(issue.GetAttachments())
+	if err != nil {
+		return err
+	}
+
+	// Filing an open issue is refused, so don't leave its gists behind
+	existing, err := b.issueExists(b.redactFor(issue.GetService(), issue.GetTitle()))
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errIssueExists
+	}
+
+	links := []string{}
+	for _, a := range issue.GetAttachments() {
+		url, err := b.uploadAttachment(ctx, issue, a)
+		if err != nil {
+			return err
+		}
+		links = append(links, fmt.Sprintf("- [%v](%v)", a.GetName(), url))
+	}
+
+	issue.Body = issue.GetBody() + "\n\nAttachments:\n" + strings.Join(links, "\n")
+	return nil
+}
+
+func (b *GithubBridge) readAttachments(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, ATTACHMENTKEY, &pbgh.AttachmentIndex{})
+	if err != nil {
+		return err
+	}
+
+	b.attachMutex.Lock()
+	defer b.attachMutex.Unlock()
+	b.attachments = data.(*pbgh.AttachmentIndex)
+	return nil
+}
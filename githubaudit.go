@@ -0,0 +1,111 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// AUDITKEY the record of every change we've made on github
+	AUDITKEY = "/github.com/brotherlogic/githubcard/audit"
+
+	// How long we hold on to audit events
+	auditRetention = time.Hour * 24 * 90
+
+	// The caller recorded for changes made by our own tasks
+	selfCaller = "githubcard"
+)
+
+// splitIssueURL pulls the repo and number out of an issue url
+func splitIssueURL(url string) (string, int32) {
+	parts := issueURL.FindStringSubmatch(url)
+	if parts == nil {
+		return "", 0
+	}
+	number, _ := strconv.Atoi(parts[3])
+	return parts[2], int32(number)
+}
+
+// audit records a change made on github
+func (b *GithubBridge) audit(ctx context.Context, caller, rpc, repo string, number int32, err error) {
+	event := &pbgh.AuditEvent{Caller: caller, Rpc: rpc, Repo: repo, Number: number, Outcome: "ok", Timestamp: time.Now().Unix()}
+	if err != nil {
+		event.Outcome = err.Error()
+	}
+	b.recordEvent(ctx, event)
+}
+
+func (b *GithubBridge) recordEvent(ctx context.Context, event *pbgh.AuditEvent) {
+	b.auditMutex.Lock()
+	defer b.auditMutex.Unlock()
+	b.auditLog.Events = append(b.auditLog.Events, event)
+	b.KSclient.Save(ctx, AUDITKEY, b.auditLog)
+}
+
+// auditTarget records a change to something other than an issue
+func (b *GithubBridge) auditTarget(ctx context.Context, caller, rpc, target string, err error) {
+	event := &pbgh.AuditEvent{Caller: caller, Rpc: rpc, Target: target, Outcome: "ok", Timestamp: time.Now().Unix()}
+	if err != nil {
+		event.Outcome = err.Error()
+	}
+	b.recordEvent(ctx, event)
+}
+
+// auditURL records a change made to the issue at the given url
+func (b *GithubBridge) auditURL(ctx context.Context, caller, rpc, url string, err error) {
+	repo, number := splitIssueURL(url)
+	b.audit(ctx, caller, rpc, repo, number, err)
+}
+
+// auditEvents finds the events matching the request
+func (b *GithubBridge) auditEvents(req *pbgh.AuditRequest) []*pbgh.AuditEvent {
+	b.auditMutex.Lock()
+	defer b.auditMutex.Unlock()
+
+	events := []*pbgh.AuditEvent{}
+	for _, event := range b.auditLog.GetEvents() {
+		if (len(req.GetCaller()) > 0 && req.GetCaller() != event.GetCaller()) ||
+			(len(req.GetRpc()) > 0 && req.GetRpc() != event.GetRpc()) ||
+			(len(req.GetRepo()) > 0 && req.GetRepo() != event.GetRepo()) ||
+			(req.GetSince() > 0 && event.GetTimestamp() < req.GetSince()) ||
+			(req.GetUntil() > 0 && event.GetTimestamp() > req.GetUntil()) ||
+			(req.GetFailuresOnly() && event.GetOutcome() == "ok") {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// cleanAudit drops events past retention, nothing else ever leaves the log
+func (b *GithubBridge) cleanAudit(ctx context.Context) {
+	b.auditMutex.Lock()
+	defer b.auditMutex.Unlock()
+
+	events := []*pbgh.AuditEvent{}
+	for _, event := range b.auditLog.GetEvents() {
+		if time.Now().Sub(time.Unix(event.GetTimestamp(), 0)) < auditRetention {
+			events = append(events, event)
+		}
+	}
+	if len(events) != len(b.auditLog.GetEvents()) {
+		b.auditLog.Events = events
+		b.KSclient.Save(ctx, AUDITKEY, b.auditLog)
+	}
+}
+
+func (b *GithubBridge) readAudit(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, AUDITKEY, &pbgh.AuditLog{})
+	if err != nil {
+		return err
+	}
+
+	b.auditMutex.Lock()
+	defer b.auditMutex.Unlock()
+	b.auditLog = data.(*pbgh.AuditLog)
+	return nil
+}
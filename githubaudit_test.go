@@ -0,0 +1,112 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestAuditAddIssue(t *testing.T) {
+	s := InitTest()
+
+	s.AddIssue(context.Background(), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+	s.AddIssue(context.Background(), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+
+	events := s.auditEvents(&pb.AuditRequest{Rpc: "AddIssue"})
+	if len(events) != 2 || events[0].Number != 494 || events[0].Outcome != "ok" || events[0].Caller != "unknown" || events[0].Repo != "Home" {
+		t.Fatalf("Bad audit events: %v", events)
+	}
+
+	failures := s.auditEvents(&pb.AuditRequest{FailuresOnly: true})
+	if len(failures) != 1 || failures[0] != events[1] {
+		t.Errorf("Bad failures: %v", failures)
+	}
+}
+
+func TestAuditConfigChanges(t *testing.T) {
+	s := InitTest()
+
+	s.SetQuotas(context.Background(), &pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "crasher", PerMinute: 1, Burst: 5}}})
+	s.SetAuthPolicy(callerContext("recordgetter"), &pb.AuthPolicy{})
+
+	quotas := s.auditEvents(&pb.AuditRequest{Rpc: "SetQuotas"})
+	if len(quotas) != 1 || quotas[0].Target != QUOTAKEY || quotas[0].Outcome != "ok" {
+		t.Errorf("Quota change was not audited: %v", quotas)
+	}
+
+	auth := s.auditEvents(&pb.AuditRequest{Rpc: "SetAuthPolicy", FailuresOnly: true})
+	if len(auth) != 1 || auth[0].Caller != "recordgetter" {
+		t.Errorf("Denied policy change was not audited: %v", auth)
+	}
+}
+
+func TestAuditGist(t *testing.T) {
+	s := InitTest()
+
+	url, err := s.createGist(context.Background(), "Testing", map[string]string{"body.txt": "log line"})
+	if err != nil {
+		t.Fatalf("Unable to create gist: %v", err)
+	}
+
+	events := s.auditEvents(&pb.AuditRequest{Rpc: "create_gist"})
+	if len(events) != 1 || events[0].Target != url {
+		t.Errorf("Gist was not audited: %v", events)
+	}
+}
+
+func TestAuditCardAction(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubaction-1", Text: "{\"action\": \"close\", \"issue\": \"https://api.github.com/repos/brotherlogic/Home/issues/12\"}"}}}
+	s.cards = cards
+
+	err := s.processCardActions(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error processing actions: %v", err)
+	}
+
+	events := s.auditEvents(&pb.AuditRequest{Caller: "cardserver"})
+	if len(events) != 1 || events[0].Rpc != "card_close" || events[0].Repo != "Home" || events[0].Number != 12 {
+		t.Errorf("Bad audit events: %v", events)
+	}
+}
+
+func TestListAuditEventsFilters(t *testing.T) {
+	s := InitTest()
+	now := time.Now().Unix()
+	s.auditLog = &pb.AuditLog{Events: []*pb.AuditEvent{
+		&pb.AuditEvent{Caller: "recordgetter", Rpc: "AddIssue", Repo: "recordgetter", Outcome: "ok", Timestamp: now - 100},
+		&pb.AuditEvent{Caller: "recordgetter", Rpc: "AddIssue", Repo: "Home", Outcome: "ok", Timestamp: now},
+		&pb.AuditEvent{Caller: "githubcard", Rpc: "apply_stale", Repo: "Home", Outcome: "ok", Timestamp: now},
+	}}
+
+	resp, err := s.ListAuditEvents(context.Background(), &pb.AuditRequest{Caller: "recordgetter", Since: now - 10})
+	if err != nil {
+		t.Fatalf("Error listing events: %v", err)
+	}
+	if len(resp.GetEvents()) != 1 || resp.GetEvents()[0].Repo != "Home" {
+		t.Errorf("Bad events: %v", resp.GetEvents())
+	}
+
+	resp, err = s.ListAuditEvents(context.Background(), &pb.AuditRequest{Repo: "Home", Until: now - 10})
+	if err != nil || len(resp.GetEvents()) != 0 {
+		t.Errorf("Bad events: %v (%v)", resp.GetEvents(), err)
+	}
+}
+
+func TestCleanAudit(t *testing.T) {
+	s := InitTest()
+	s.auditLog = &pb.AuditLog{Events: []*pb.AuditEvent{
+		&pb.AuditEvent{Rpc: "old", Timestamp: time.Now().Add(-auditRetention - time.Hour).Unix()},
+		&pb.AuditEvent{Rpc: "new", Timestamp: time.Now().Unix()},
+	}}
+
+	s.cleanAudit(context.Background())
+
+	if len(s.auditLog.GetEvents()) != 1 || s.auditLog.GetEvents()[0].Rpc != "new" {
+		t.Errorf("Audit log was not cleaned: %v", s.auditLog)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// AUTHKEY the policy for who can do what to which repos
+	AUTHKEY = "/github.com/brotherlogic/githubcard/auth"
+
+	// unknownCaller is anyone who didn't present a certificate
+	unknownCaller = "unknown"
+)
+
+// repoScoped are the rpcs that act on a repo, so an empty repo needs an explicit grant
+var repoScoped = map[string]bool{
+	"AddIssue":     true,
+	"Get":          true,
+	"ExplainScore": true,
+	"ResolveIssue": true,
+}
+
+// callerFor names whoever made an RPC by their mTLS certificate, addresses can be shared or spoofed
+func callerFor(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return unknownCaller
+	}
+
+	if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.PeerCertificates) > 0 && len(info.State.PeerCertificates[0].Subject.CommonName) > 0 {
+		return info.State.PeerCertificates[0].Subject.CommonName
+	}
+	return unknownCaller
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == "*" || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// allowed checks the policy, calls that don't touch a repo only need the rpc
+func allowed(policy *pbgh.AuthPolicy, caller, rpc, repo string) bool {
+	if len(policy.GetPermissions()) == 0 {
+		return true
+	}
+	if caller == unknownCaller {
+		return false
+	}
+
+	for _, p := range policy.GetPermissions() {
+		if (p.GetCaller() == "*" || p.GetCaller() == caller) && contains(p.GetRpcs(), rpc) && ((len(repo) == 0 && !repoScoped[rpc]) || contains(p.GetRepos(), repo)) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize rejects callers the policy doesn't let make this call
+func (b *GithubBridge) authorize(ctx context.Context, rpc, repo string) error {
+	caller := callerFor(ctx)
+
+	// The admin can always see and fix the policy, so a bad policy can't lock them out
+	if caller == b.admin && (rpc == "SetAuthPolicy" || rpc == "GetAuthPolicy") {
+		return nil
+	}
+
+	// Only the admin can change the policy, even before there is one
+	if rpc == "SetAuthPolicy" {
+		b.Log(fmt.Sprintf("Denying %v to %v", rpc, caller))
+		return status.Errorf(codes.PermissionDenied, "%v may not call %v", caller, rpc)
+	}
+
+	if !allowed(b.auth, caller, rpc, repo) {
+		b.Log(fmt.Sprintf("Denying %v to %v on %v", rpc, caller, repo))
+		return status.Errorf(codes.PermissionDenied, "%v may not call %v on %v", caller, rpc, repo)
+	}
+	return nil
+}
+
+func (b *GithubBridge) readAuth(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, AUTHKEY, &pbgh.AuthPolicy{})
+	if err != nil {
+		return err
+	}
+	b.auth = data.(*pbgh.AuthPolicy)
+	return nil
+}
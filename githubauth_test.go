@@ -0,0 +1,164 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func callerContext(name string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.ParseIP("192.168.86.28"), Port: 1234},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{&x509.Certificate{Subject: pkix.Name{CommonName: name}}}}},
+	})
+}
+
+func TestCallerFor(t *testing.T) {
+	if callerFor(context.Background()) != unknownCaller {
+		t.Errorf("Bad caller for empty context")
+	}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.168.86.28"), Port: 1234}})
+	if callerFor(ctx) != unknownCaller {
+		t.Errorf("Caller was identified by address: %v", callerFor(ctx))
+	}
+
+	if callerFor(callerContext("recordgetter")) != "recordgetter" {
+		t.Errorf("Bad caller for mTLS peer: %v", callerFor(callerContext("recordgetter")))
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	policy := &pb.AuthPolicy{Permissions: []*pb.Permission{
+		&pb.Permission{Caller: "recordgetter", Repos: []string{"crasher"}, Rpcs: []string{"AddIssue", "Get"}},
+		&pb.Permission{Caller: "*", Repos: []string{"*"}, Rpcs: []string{"Get"}},
+	}}
+
+	for _, c := range []struct {
+		caller, rpc, repo string
+		allowed           bool
+	}{
+		{"recordgetter", "AddIssue", "crasher", true},
+		{"recordgetter", "AddIssue", "Home", false},
+		{"crasher", "AddIssue", "crasher", false},
+		{"crasher", "Get", "Home", true},
+		{"crasher", "SetScoring", "", false},
+		{"recordgetter", "AddIssue", "", false},
+		{"crasher", "Get", "", true},
+		{unknownCaller, "Get", "Home", false},
+	} {
+		if allowed(policy, c.caller, c.rpc, c.repo) != c.allowed {
+			t.Errorf("Bad decision for %v", c)
+		}
+	}
+
+	if !allowed(&pb.AuthPolicy{}, "anyone", "SetScoring", "") {
+		t.Errorf("Empty policy should allow everything")
+	}
+}
+
+func TestSetAuthPolicyNeedsAdmin(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.SetAuthPolicy(callerContext("recordgetter"), &pb.AuthPolicy{Permissions: []*pb.Permission{&pb.Permission{Caller: "*", Repos: []string{"*"}, Rpcs: []string{"*"}}}})
+	if status.Code(err) != codes.PermissionDenied || len(s.auth.GetPermissions()) != 0 {
+		t.Errorf("Policy change without a policy was not denied: %v", err)
+	}
+
+	_, err = s.SetAuthPolicy(context.Background(), &pb.AuthPolicy{})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Unidentified policy change was not denied: %v", err)
+	}
+
+	_, err = s.SetAuthPolicy(callerContext("admin"), &pb.AuthPolicy{Permissions: []*pb.Permission{&pb.Permission{Caller: "recordgetter", Repos: []string{"Home"}, Rpcs: []string{"*"}}}})
+	if err != nil || len(s.auth.GetPermissions()) != 1 {
+		t.Errorf("Admin could not set the policy: %v", err)
+	}
+}
+
+func TestAddIssueWithoutRepoDenied(t *testing.T) {
+	s := InitTest()
+	s.auth = &pb.AuthPolicy{Permissions: []*pb.Permission{&pb.Permission{Caller: "recordgetter", Repos: []string{"crasher"}, Rpcs: []string{"AddIssue"}}}}
+
+	_, err := s.AddIssue(callerContext("recordgetter"), &pb.Issue{Title: "Testing", Body: "This is a test issue"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Issue without a repo was not denied: %v", err)
+	}
+}
+
+func TestAddIssueDenied(t *testing.T) {
+	s := InitTest()
+	s.auth = &pb.AuthPolicy{Permissions: []*pb.Permission{&pb.Permission{Caller: "recordgetter", Repos: []string{"crasher"}, Rpcs: []string{"AddIssue"}}}}
+
+	_, err := s.AddIssue(callerContext("recordgetter"), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Issue was not denied: %v", err)
+	}
+
+	events := s.auditEvents(&pb.AuditRequest{Caller: "recordgetter", FailuresOnly: true})
+	if len(events) != 1 {
+		t.Errorf("Denial was not audited: %v", events)
+	}
+
+	_, err = s.SetAuthPolicy(callerContext("recordgetter"), &pb.AuthPolicy{})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Policy change was not denied: %v", err)
+	}
+}
+
+func TestResolveIssueDenied(t *testing.T) {
+	s := InitTest()
+	s.tracked = &pb.TrackedIssues{Issues: []*pb.TrackedIssue{&pb.TrackedIssue{Fingerprint: "home-down", Service: "Home", Number: 12}}}
+	s.auth = &pb.AuthPolicy{Permissions: []*pb.Permission{&pb.Permission{Caller: "recordgetter", Repos: []string{"crasher"}, Rpcs: []string{"*"}}}}
+
+	_, err := s.ResolveIssue(callerContext("recordgetter"), &pb.ResolveRequest{Fingerprint: "home-down", Service: "Home"})
+	if status.Code(err) != codes.PermissionDenied || s.tracked.GetIssues()[0].GetResolvedAt() != 0 {
+		t.Errorf("Resolve was not denied: %v", err)
+	}
+}
+
+func TestAdminNotLockedOut(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.SetAuthPolicy(callerContext("admin"), &pb.AuthPolicy{Permissions: []*pb.Permission{&pb.Permission{Caller: "recordgetter", Repos: []string{"Home"}, Rpcs: []string{"AddIssue"}}}})
+	if err != nil {
+		t.Fatalf("Admin could not set the policy: %v", err)
+	}
+
+	_, err = s.GetAuthPolicy(callerContext("admin"), &pb.Empty{})
+	if err != nil {
+		t.Errorf("Admin could not read the policy: %v", err)
+	}
+	_, err = s.SetAuthPolicy(callerContext("admin"), &pb.AuthPolicy{})
+	if err != nil {
+		t.Errorf("Admin was locked out by their own policy: %v", err)
+	}
+}
+
+func TestAddFailureFiledAsSelf(t *testing.T) {
+	s := InitTest()
+	s.auth = &pb.AuthPolicy{Permissions: []*pb.Permission{&pb.Permission{Caller: "recordgetter", Repos: []string{"MadeUpService"}, Rpcs: []string{"AddIssue"}}}}
+
+	_, err := s.AddIssue(callerContext("recordgetter"), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "MadeUpService"})
+	if err == nil {
+		t.Fatalf("Issue for a missing repo was filed")
+	}
+
+	events := s.auditEvents(&pb.AuditRequest{Rpc: "add_failure"})
+	if len(events) != 1 || events[0].Caller != selfCaller || events[0].Outcome != "ok" {
+		t.Errorf("Failure report was not filed as ourselves: %v", events)
+	}
+	if len(s.auditEvents(&pb.AuditRequest{Caller: "recordgetter"})) != 1 {
+		t.Errorf("Failure report was charged to the caller: %v", s.auditEvents(&pb.AuditRequest{}))
+	}
+}
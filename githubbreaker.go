@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Failures in a row before we stop calling github
+	breakerThreshold = 5
+
+	// How long we leave github alone before probing it
+	breakerCooldown = time.Minute
+)
+
+// States of the circuit breaker
+const (
+	breakerClosed   = "closed"
+	breakerOpen     = "open"
+	breakerHalfOpen = "half-open"
+)
+
+// breaker tracks whether github is up
+type breaker struct {
+	state    string
+	failures int
+	opened   time.Time
+}
+
+// allowRequest lets a call through unless github is down, once the cooldown
+// has passed a single call goes through as a probe
+func (b *GithubBridge) allowRequest(now time.Time) error {
+	b.circuitMutex.Lock()
+	defer b.circuitMutex.Unlock()
+
+	switch b.circuit.state {
+	case breakerOpen:
+		if now.Sub(b.circuit.opened) < breakerCooldown {
+			return status.Errorf(codes.Unavailable, "Github is down, not retrying until %v", b.circuit.opened.Add(breakerCooldown))
+		}
+		b.circuit.state = breakerHalfOpen
+	case breakerHalfOpen:
+		return status.Errorf(codes.Unavailable, "Github is down, waiting on a probe")
+	}
+	return nil
+}
+
+// recordResult counts server errors and timeouts towards opening the breaker
+func (b *GithubBridge) recordResult(resp *http.Response, err error, now time.Time) {
+	b.circuitMutex.Lock()
+	defer b.circuitMutex.Unlock()
+
+	if err == nil && resp.StatusCode < 500 {
+		if b.circuit.state != breakerClosed {
+			b.Log("Github is back, closing the breaker")
+		}
+		b.circuit.state = breakerClosed
+		b.circuit.failures = 0
+		return
+	}
+
+	b.circuit.failures++
+	if b.circuit.state == breakerHalfOpen || b.circuit.failures >= breakerThreshold {
+		if b.circuit.state == breakerClosed {
+			b.Log(fmt.Sprintf("Github is down after %v failures, opening the breaker", b.circuit.failures))
+		}
+		b.circuit.state = breakerOpen
+		b.circuit.opened = now
+	}
+}
+
+// circuitOpen reports if we've stopped calling github
+func (b *GithubBridge) circuitOpen() bool {
+	b.circuitMutex.Lock()
+	defer b.circuitMutex.Unlock()
+	return b.circuit.state != breakerClosed
+}
+
+// circuitState reports the breaker state and failure count for GetState
+func (b *GithubBridge) circuitState() (string, int) {
+	b.circuitMutex.Lock()
+	defer b.circuitMutex.Unlock()
+	return b.circuit.state, b.circuit.failures
+}
+
+// probeGithub checks if github has come back while the breaker is open
+func (b *GithubBridge) probeGithub(ctx context.Context) {
+	if !b.circuitOpen() {
+		return
+	}
+
+	_, err := b.visitURL("https://api.github.com/rate_limit")
+	if err != nil {
+		b.Log(fmt.Sprintf("Github is still down: %v", err))
+	}
+}
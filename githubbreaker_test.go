@@ -0,0 +1,148 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+// downGetter is github having an outage
+type downGetter struct{}
+
+func (httpGetter downGetter) Post(url string, data string) (*http.Response, error) {
+	return &http.Response{StatusCode: 502, Body: ioutil.NopCloser(strings.NewReader("Bad Gateway"))}, nil
+}
+
+func (httpGetter downGetter) Patch(url string, data string) (*http.Response, error) {
+	return httpGetter.Post(url, data)
+}
+
+func (httpGetter downGetter) Get(url string) (*http.Response, error) {
+	return httpGetter.Post(url, "")
+}
+
+func TestBreakerOpensOnServerErrors(t *testing.T) {
+	s := InitTest()
+	s.getter = downGetter{}
+
+	for i := 0; i < breakerThreshold; i++ {
+		s.visitURL("https://api.github.com/repos/brotherlogic/Home/issues")
+	}
+	if s.circuit.state != breakerOpen || s.ReportHealth() {
+		t.Fatalf("Breaker did not open: %v", s.circuit)
+	}
+
+	s.getter = testFileGetter{}
+	_, err := s.visitURL("https://api.github.com/repos/brotherlogic/Home/issues")
+	if err == nil {
+		t.Errorf("Open breaker let a call through")
+	}
+}
+
+func TestBreakerIgnoresClientErrors(t *testing.T) {
+	s := InitTest()
+
+	for i := 0; i < breakerThreshold; i++ {
+		s.visitURL("https://api.github.com/repos/brotherlogic/Missing/issues")
+	}
+	if s.circuit.state != breakerClosed {
+		t.Errorf("Breaker opened on 404s: %v", s.circuit)
+	}
+}
+
+func TestBreakerProbe(t *testing.T) {
+	s := InitTest()
+	now := time.Now()
+
+	for i := 0; i < breakerThreshold; i++ {
+		s.allowRequest(now)
+		s.recordResult(nil, context.DeadlineExceeded, now)
+	}
+	if s.allowRequest(now.Add(breakerCooldown/2)) == nil {
+		t.Fatalf("Call was let through during cooldown")
+	}
+
+	if s.allowRequest(now.Add(breakerCooldown)) != nil || s.circuit.state != breakerHalfOpen {
+		t.Fatalf("Probe was not let through: %v", s.circuit)
+	}
+	if s.allowRequest(now.Add(breakerCooldown)) == nil {
+		t.Errorf("Second call was let through alongside the probe")
+	}
+
+	s.recordResult(nil, context.DeadlineExceeded, now.Add(breakerCooldown))
+	if s.circuit.state != breakerOpen || !s.circuit.opened.Equal(now.Add(breakerCooldown)) {
+		t.Errorf("Failed probe did not reopen the breaker: %v", s.circuit)
+	}
+}
+
+func TestProbeClosesBreaker(t *testing.T) {
+	s := InitTest()
+	s.circuit = &breaker{state: breakerOpen, failures: breakerThreshold, opened: time.Now().Add(-breakerCooldown)}
+
+	s.probeGithub(context.Background())
+
+	if s.circuit.state != breakerClosed || s.circuit.failures != 0 || !s.ReportHealth() {
+		t.Errorf("Breaker did not close: %v", s.circuit)
+	}
+}
+
+func TestAddIssueQueuedWhenOpen(t *testing.T) {
+	s := InitTest()
+	s.circuit = &breaker{state: breakerOpen, opened: time.Now()}
+
+	issue, err := s.AddIssue(context.Background(), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+	if err != nil || issue.GetNumber() != 0 {
+		t.Fatalf("Issue was not queued: %v, %v", issue, err)
+	}
+	if len(s.issues) != 1 {
+		t.Errorf("Issue is not in the queue: %v", s.issues)
+	}
+
+	s.procSticky(context.Background())
+	if len(s.issues) != 1 {
+		t.Errorf("Queue was processed while github is down: %v", s.issues)
+	}
+
+	err = s.passover()
+	if err != nil {
+		t.Errorf("Passover failed while github is down: %v", err)
+	}
+
+	s.circuit = &breaker{state: breakerClosed}
+	s.procSticky(context.Background())
+	if len(s.issues) != 0 {
+		t.Errorf("Queue was not processed once github came back: %v", s.issues)
+	}
+}
+
+func TestStickyFailureSaved(t *testing.T) {
+	s := InitTest()
+	s.getter = downGetter{}
+
+	issue, err := s.AddIssue(context.Background(), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home", Sticky: true})
+	if err != nil || issue.GetNumber() != 0 {
+		t.Fatalf("Issue was not queued: %v, %v", issue, err)
+	}
+
+	data, _, err := s.KSclient.Read(context.Background(), KEY, &pb.IssueList{})
+	if err != nil || len(data.(*pb.IssueList).GetIssues()) != 1 {
+		t.Errorf("Queued issue was not saved: %v, %v", data, err)
+	}
+}
+
+func TestGetStateReadsCircuit(t *testing.T) {
+	s := InitTest()
+	s.circuit = &breaker{state: breakerOpen, failures: 3}
+
+	for _, state := range s.GetState() {
+		if (state.Key == "circuit" && state.Text != breakerOpen) || (state.Key == "circuit_failures" && state.Value != 3) {
+			t.Errorf("Bad circuit state: %v", state)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// BUILDKEY the repos whose builds we watch
+	BUILDKEY = "/github.com/brotherlogic/githubcard/builds"
+
+	// Cards for default branches which are failing
+	buildCardPrefix = "githubbuild-"
+
+	// A red build outranks all but the most urgent issues
+	buildPriority = 5000
+)
+
+// readJSON fetches a github url into a map
+func (b *GithubBridge) readJSON(url string) (map[string]interface{}, error) {
+	body, err := b.visitURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+	err = json.Unmarshal([]byte(body), &data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// errBuildPending is returned when a build is still running and we can't tell if it's red
+var errBuildPending = errors.New("Build is still running")
+
+// buildFailing reports if the default branch of the repo is red, and which branch that is;
+// a pending status with nothing failing returns errBuildPending so we keep what we had
+func (b *GithubBridge) buildFailing(repo string) (bool, string, error) {
+	base := "https://api.github.com/repos/brotherlogic/" + repo
+	data, err := b.readJSON(base)
+	if err != nil {
+		return false, "", err
+	}
+	branch, ok := data["default_branch"].(string)
+	if !ok {
+		return false, "", fmt.Errorf("No default branch for %v", repo)
+	}
+
+	status, err := b.readJSON(base + "/commits/" + branch + "/status")
+	if err != nil {
+		return false, branch, err
+	}
+	if status["state"] == "failure" || status["state"] == "error" {
+		return true, branch, nil
+	}
+
+	// A repo without any statuses reports pending with nothing to wait on
+	count, _ := status["total_count"].(float64)
+	pending := status["state"] == "pending" && count > 0
+
+	// Only completed runs have a conclusion, anything in progress is left to the next check
+	runs, err := b.readJSON(base + "/actions/runs?branch=" + branch + "&status=completed&per_page=1")
+	if err != nil {
+		return false, branch, err
+	}
+	if list, ok := runs["workflow_runs"].([]interface{}); ok && len(list) > 0 {
+		if run, ok := list[0].(map[string]interface{}); ok && run["conclusion"] == "failure" {
+			return true, branch, nil
+		}
+	}
+
+	if pending {
+		return false, branch, errBuildPending
+	}
+	return false, branch, nil
+}
+
+// buildIssueTitle names the repo, titles are de-duplicated across every repo we file into
+func buildIssueTitle(repo, branch string) string {
+	return repo + " build failing on " + branch
+}
+
+// buildCard builds the card for a failing default branch
+func buildCard(repo, branch string) *pb.Card {
+	card := &pb.Card{}
+	card.Text = repo + " is failing on " + branch + "\n\nhttps://github.com/brotherlogic/" + repo + "/actions"
+	card.Hash = buildCardPrefix + repo
+	card.Channel = pb.Card_ISSUES
+	card.Priority = buildPriority
+	return card
+}
+
+// buildIssue finds the open issue we filed for a failing build
+func (b *GithubBridge) buildIssue(repo, branch string) *pbgh.Issue {
+	b.mirrorMutex.Lock()
+	defer b.mirrorMutex.Unlock()
+
+	for _, issue := range b.mirror.GetIssues() {
+		if issue.GetService() == repo && issue.GetTitle() == buildIssueTitle(repo, branch) {
+			return issue
+		}
+	}
+	return nil
+}
+
+// updateBuildIssue files an issue for a red build and closes it once green
+func (b *GithubBridge) updateBuildIssue(ctx context.Context, repo, branch string, failing bool) error {
+	issue := b.buildIssue(repo, branch)
+	if failing && issue == nil {
+		_, err := b.AddIssueLocal(ctx, "brotherlogic", repo, buildIssueTitle(repo, branch), "https://github.com/brotherlogic/"+repo+"/actions")
+		b.audit(ctx, selfCaller, "check_builds", repo, 0, err)
+
+		//Filed on an earlier check, but not yet in the mirror
+		if err == errIssueExists {
+			return nil
+		}
+		return err
+	}
+
+	if !failing && issue != nil {
+		err := b.runAction(&cardAction{Action: "comment", Issue: issue.GetUrl(), Text: "Build is passing again"})
+		if err != nil {
+			return err
+		}
+		err = b.runAction(&cardAction{Action: "close", Issue: issue.GetUrl()})
+		b.auditURL(ctx, selfCaller, "check_builds", issue.GetUrl(), err)
+		if err != nil {
+			return err
+		}
+		issue.State = pbgh.Issue_CLOSED
+		b.mirrorIssue(issue)
+		b.saveMirror(ctx)
+	}
+	return nil
+}
+
+// updateBuildCards raises a card for every watched repo with a red default branch
+func (b *GithubBridge) updateBuildCards(ctx context.Context) error {
+	cards, err := b.cards.GetCards(ctx, &pb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	desired := []*pb.Card{}
+	for _, watch := range b.builds.GetRepos() {
+		failing, branch, err := b.buildFailing(watch.GetRepo())
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to check build for %v: %v", watch.GetRepo(), err))
+
+			// Keep whatever card we had until we know better
+			for _, card := range cards.GetCards() {
+				if card.Hash == buildCardPrefix+watch.GetRepo() {
+					desired = append(desired, card)
+				}
+			}
+			continue
+		}
+
+		if failing {
+			desired = append(desired, buildCard(watch.GetRepo(), branch))
+		}
+
+		if watch.GetFileIssue() {
+			err = b.updateBuildIssue(ctx, watch.GetRepo(), branch, failing)
+			if err != nil {
+				b.Log(fmt.Sprintf("Unable to update build issue for %v: %v", watch.GetRepo(), err))
+			}
+		}
+	}
+
+	return b.applyDiff(ctx, diffCards(buildCardPrefix, cards.GetCards(), desired, map[string]bool{}))
+}
+
+func (b *GithubBridge) checkBuilds(ctx context.Context) {
+	if b.Registry == nil || !b.Registry.Master {
+		return
+	}
+
+	err := b.updateBuildCards(ctx)
+	if err != nil {
+		b.Log(fmt.Sprintf("Unable to update build cards: %v", err))
+	}
+}
+
+func (b *GithubBridge) readBuilds(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, BUILDKEY, &pbgh.BuildConfig{})
+	if err != nil {
+		return err
+	}
+	b.builds = data.(*pbgh.BuildConfig)
+	return nil
+}
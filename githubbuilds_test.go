@@ -0,0 +1,114 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestBuildFailing(t *testing.T) {
+	s := InitTest()
+
+	for repo, expected := range map[string]bool{"Home": false, "crasher": true, "recordgetter": true} {
+		failing, branch, err := s.buildFailing(repo)
+		if err != nil {
+			t.Fatalf("Error checking %v: %v", repo, err)
+		}
+		if failing != expected || branch != "master" {
+			t.Errorf("Bad build state for %v: %v on %v", repo, failing, branch)
+		}
+	}
+}
+
+func TestBuildFailingMissingRepo(t *testing.T) {
+	s := InitTest()
+
+	_, _, err := s.buildFailing("MadeUpService")
+	if err == nil {
+		t.Errorf("Missing repo did not fail")
+	}
+}
+
+func TestUpdateBuildCards(t *testing.T) {
+	s := InitTest()
+	s.builds = &pb.BuildConfig{Repos: []*pb.BuildWatch{&pb.BuildWatch{Repo: "Home"}, &pb.BuildWatch{Repo: "crasher"}}}
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubbuild-Home"}, &pbc.Card{Hash: "githubissue-other"}}}
+	s.cards = cards
+
+	err := s.updateBuildCards(context.Background())
+	if err != nil {
+		t.Fatalf("Error updating build cards: %v", err)
+	}
+
+	if len(cards.cards) != 2 || cards.cards[0].Hash != "githubissue-other" || cards.cards[1].Hash != "githubbuild-crasher" {
+		t.Errorf("Build cards were not updated: %v", cards.cards)
+	}
+}
+
+func TestBuildIssueClosedWhenGreen(t *testing.T) {
+	s := InitTest()
+	s.builds = &pb.BuildConfig{Repos: []*pb.BuildWatch{&pb.BuildWatch{Repo: "Home", FileIssue: true}}}
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{&pb.Issue{Service: "Home", Title: "Home build failing on master", Url: "https://api.github.com/repos/brotherlogic/Home/issues/12", State: pb.Issue_OPEN}}}
+
+	err := s.updateBuildCards(context.Background())
+	if err != nil {
+		t.Fatalf("Error updating build cards: %v", err)
+	}
+
+	if len(s.mirror.GetIssues()) != 0 {
+		t.Errorf("Build issue was not closed: %v", s.mirror.GetIssues())
+	}
+}
+
+func TestSetBuildsBadRepo(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.SetBuilds(context.Background(), &pb.BuildConfig{Repos: []*pb.BuildWatch{&pb.BuildWatch{Repo: "bad/repo"}}})
+	if err == nil {
+		t.Errorf("Bad repo was accepted")
+	}
+}
+
+func TestBuildIssuePerRepo(t *testing.T) {
+	s := InitTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{&pb.Issue{Service: "Home", Title: buildIssueTitle("Home", "master"), Url: "https://api.github.com/repos/brotherlogic/Home/issues/12", State: pb.Issue_OPEN}}}
+
+	if s.buildIssue("crasher", "master") != nil {
+		t.Fatalf("Build issue for one repo was found for another")
+	}
+
+	err := s.updateBuildIssue(context.Background(), "crasher", "master", true)
+	if err != nil {
+		t.Fatalf("Unable to file build issue: %v", err)
+	}
+	if len(sent) != 1 || !strings.Contains(sent[0], "crasher build failing on master") {
+		t.Errorf("Build issue was not filed for the repo: %v", sent)
+	}
+}
+
+func TestBuildPendingKeepsState(t *testing.T) {
+	s := InitTest()
+	s.builds = &pb.BuildConfig{Repos: []*pb.BuildWatch{&pb.BuildWatch{Repo: "gobuildmaster", FileIssue: true}}}
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{&pb.Issue{Service: "gobuildmaster", Title: buildIssueTitle("gobuildmaster", "master"), Url: "https://api.github.com/repos/brotherlogic/gobuildmaster/issues/12", State: pb.Issue_OPEN}}}
+	cards := &testCardClient{cards: []*pbc.Card{buildCard("gobuildmaster", "master")}}
+	s.cards = cards
+
+	_, _, err := s.buildFailing("gobuildmaster")
+	if err != errBuildPending {
+		t.Fatalf("Pending build was not reported: %v", err)
+	}
+
+	err = s.updateBuildCards(context.Background())
+	if err != nil {
+		t.Fatalf("Error updating build cards: %v", err)
+	}
+	if len(cards.cards) != 1 || len(s.mirror.GetIssues()) != 1 {
+		t.Errorf("Pending build changed the state: %v, %v", cards.cards, s.mirror.GetIssues())
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+)
+
+const (
+	// The version of the card issue format we understand
+	cardIssueVersion = 2
+
+	// Cards we raise when a card issue can't be processed
+	cardErrorPrefix = "githubcarderror-"
+
+	// Cards we raise in place of a card issue github has turned down for good
+	cardFailedPrefix = "githubcardfailed-"
+)
+
+var validRepo = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
+
+// cardIssue is the request carried in the text of an addgithubissue card
+type cardIssue struct {
+	Version   int      `json:"version"`
+	Repo      string   `json:"repo"`
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	Labels    []string `json:"labels"`
+	Assignees []string `json:"assignees"`
+}
+
+// parseCardIssue reads an issue request from a card, supporting the original
+// addgithubissue-x-repo hash with title|body text as version 1
+func parseCardIssue(card *pb.Card) (*cardIssue, error) {
+	issue := &cardIssue{}
+	if strings.HasPrefix(strings.TrimSpace(card.Text), "{") {
+		err := json.Unmarshal([]byte(card.Text), issue)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse card: %v", err)
+		}
+		if issue.Version != cardIssueVersion {
+			return nil, fmt.Errorf("Unsupported version %v", issue.Version)
+		}
+	} else {
+		parts := strings.SplitN(card.Hash, "-", 3)
+		text := strings.SplitN(card.Text, "|", 2)
+		if len(parts) < 3 || len(text) != 2 {
+			return nil, errors.New("Card is not of the form addgithubissue-x-repo with title|body text")
+		}
+		issue = &cardIssue{Version: 1, Repo: parts[2], Title: text[0], Body: text[1]}
+	}
+
+	if !validRepo.MatchString(issue.Repo) {
+		return nil, fmt.Errorf("Bad repo name: %v", issue.Repo)
+	}
+	if len(strings.TrimSpace(issue.Title)) == 0 {
+		return nil, errors.New("Issue has no title")
+	}
+	return issue, nil
+}
+
+// errorCard reports back on a card we couldn't process
+func errorCard(card *pb.Card, err error) *pb.Card {
+	return reportCard(cardErrorPrefix, card, err)
+}
+
+func reportCard(prefix string, card *pb.Card, err error) *pb.Card {
+	return &pb.Card{
+		Hash:    prefix + card.Hash,
+		Text:    fmt.Sprintf("Unable to process card: %v\n\n%v", err, card.Text),
+		Channel: pb.Card_ISSUES,
+	}
+}
+
+// processCardIssues files issues for all the addgithubissue cards, removing
+// only the cards which made it to github
+func (b *GithubBridge) processCardIssues(ctx context.Context, cards []*pb.Card) error {
+	existing := make(map[string]bool)
+	for _, card := range cards {
+		existing[card.Hash] = true
+	}
+
+	for _, card := range cards {
+		if !strings.HasPrefix(card.Hash, "addgithubissue") {
+			continue
+		}
+
+		issue, err := parseCardIssue(card)
+		if err != nil {
+			b.Log(fmt.Sprintf("Bad card %v: %v", card.Hash, err))
+			if !existing[cardErrorPrefix+card.Hash] {
+				_, err = b.cards.AddCards(ctx, &pb.CardList{Cards: []*pb.Card{errorCard(card, err)}})
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		payload := Payload{Title: issue.Title, Body: issue.Body, Labels: issue.Labels, Assignees: issue.Assignees}
+		if len(payload.Assignees) == 0 {
+			payload.Assignee = "brotherlogic"
+		}
+		rb, err := b.addPayload(ctx, "brotherlogic", issue.Repo, payload)
+		r := &addResponse{}
+		retry := retryable(err)
+		if err == nil {
+			//Github took the request, so sending it again won't help
+			retry = false
+			if json.Unmarshal(rb, r) != nil || r.Number == 0 {
+				err = fmt.Errorf("Github did not file: %v", string(rb))
+			}
+		}
+		b.audit(ctx, "cardserver", "card_issue", issue.Repo, r.Number, err)
+
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to file %v: %v", card.Hash, err))
+			if retry {
+				continue
+			}
+
+			//Trying again won't help, so swap the card for one saying why
+			if !existing[cardFailedPrefix+card.Hash] {
+				_, err = b.cards.AddCards(ctx, &pb.CardList{Cards: []*pb.Card{reportCard(cardFailedPrefix, card, err)}})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err = b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: card.Hash})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cleanErrorCards removes error cards for cards which have since gone away
+func (b *GithubBridge) cleanErrorCards(ctx context.Context, cards []*pb.Card) error {
+	existing := make(map[string]bool)
+	for _, card := range cards {
+		existing[card.Hash] = true
+	}
+
+	for _, card := range cards {
+		if strings.HasPrefix(card.Hash, cardErrorPrefix) && !existing[strings.TrimPrefix(card.Hash, cardErrorPrefix)] {
+			_, err := b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: card.Hash})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
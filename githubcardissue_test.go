@@ -0,0 +1,137 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+)
+
+func TestParseLegacyCardIssue(t *testing.T) {
+	issue, err := parseCardIssue(&pbc.Card{Hash: "addgithubissue-blah-Home", Text: "Testing|This is a test issue"})
+	if err != nil {
+		t.Fatalf("Unable to parse card: %v", err)
+	}
+
+	if issue.Version != 1 || issue.Repo != "Home" || issue.Title != "Testing" || issue.Body != "This is a test issue" {
+		t.Errorf("Card was parsed incorrectly: %v", issue)
+	}
+}
+
+func TestParseCardIssue(t *testing.T) {
+	issue, err := parseCardIssue(&pbc.Card{Hash: "addgithubissue-blah", Text: `{"version": 2, "repo": "Home", "title": "Testing", "body": "This is a test issue", "labels": ["bug"], "assignees": ["brotherlogic"]}`})
+	if err != nil {
+		t.Fatalf("Unable to parse card: %v", err)
+	}
+
+	if issue.Repo != "Home" || len(issue.Labels) != 1 || len(issue.Assignees) != 1 {
+		t.Errorf("Card was parsed incorrectly: %v", issue)
+	}
+}
+
+func TestParseLegacyCardDashedRepo(t *testing.T) {
+	issue, err := parseCardIssue(&pbc.Card{Hash: "addgithubissue-x-my-repo", Text: "Testing|Body"})
+	if err != nil || issue.Repo != "my-repo" {
+		t.Errorf("Dashed repo was parsed incorrectly: %v, %v", issue, err)
+	}
+}
+
+func TestParseBadCardIssues(t *testing.T) {
+	cards := []*pbc.Card{
+		&pbc.Card{Hash: "addgithubissue", Text: "Testing|Body"},
+		&pbc.Card{Hash: "addgithubissue-blah-Home", Text: "No pipe here"},
+		&pbc.Card{Hash: "addgithubissue-blah-Home", Text: "|Body only"},
+		&pbc.Card{Hash: "addgithubissue-blah-../etc", Text: "Testing|Body"},
+		&pbc.Card{Hash: "addgithubissue-blah", Text: `{"version": 3, "repo": "Home", "title": "Testing"}`},
+		&pbc.Card{Hash: "addgithubissue-blah", Text: `{"version": 2, "repo": "Home"`},
+	}
+
+	for _, card := range cards {
+		issue, err := parseCardIssue(card)
+		if err == nil {
+			t.Errorf("Bad card %v was parsed: %v", card, issue)
+		}
+	}
+}
+
+func TestProcessCardIssues(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{
+		&pbc.Card{Hash: "addgithubissue-blah-Home", Text: "Testing|This is a test issue"},
+		&pbc.Card{Hash: "addgithubissue-broken", Text: "Nonsense"},
+	}}
+	s.cards = cards
+
+	err := s.processCardIssues(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error processing cards: %v", err)
+	}
+
+	if len(cards.cards) != 2 || cards.cards[0].Hash != "addgithubissue-broken" || cards.cards[1].Hash != cardErrorPrefix+"addgithubissue-broken" {
+		t.Errorf("Cards were not processed correctly: %v", cards.cards)
+	}
+
+	// A second pass shouldn't raise another error card
+	err = s.processCardIssues(context.Background(), cards.cards)
+	if err != nil || len(cards.cards) != 2 {
+		t.Errorf("Second pass was wrong: %v, %v", cards.cards, err)
+	}
+}
+
+func TestProcessCardIssuesKeepsFailures(t *testing.T) {
+	s := InitTest()
+	s.getter = failGetter{}
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "addgithubissue-blah-Home", Text: "Testing|This is a test issue"}}}
+	s.cards = cards
+
+	err := s.processCardIssues(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error processing cards: %v", err)
+	}
+
+	if len(cards.cards) != 1 {
+		t.Errorf("Unfiled card was removed: %v", cards.cards)
+	}
+}
+
+func TestProcessCardIssuesPermanentFailure(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "addgithubissue-blah-MissingRepo", Text: "Testing|This is a test issue"}}}
+	s.cards = cards
+
+	err := s.processCardIssues(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error processing cards: %v", err)
+	}
+
+	if len(cards.cards) != 1 || cards.cards[0].Hash != cardFailedPrefix+"addgithubissue-blah-MissingRepo" || !strings.Contains(cards.cards[0].Text, "This is a test issue") {
+		t.Errorf("Card was not swapped for a failure card: %v", cards.cards)
+	}
+}
+
+func TestCleanErrorCards(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{
+		&pbc.Card{Hash: "addgithubissue-broken"},
+		&pbc.Card{Hash: cardErrorPrefix + "addgithubissue-broken"},
+		&pbc.Card{Hash: cardErrorPrefix + "addgithubissue-gone"},
+		&pbc.Card{Hash: cardFailedPrefix + "addgithubissue-filed"},
+	}}
+	s.cards = cards
+
+	err := s.cleanErrorCards(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error cleaning cards: %v", err)
+	}
+
+	if len(cards.cards) != 3 {
+		t.Errorf("Stale error card was not removed: %v", cards.cards)
+	}
+	for _, card := range cards.cards {
+		if card.Hash == cardErrorPrefix+"addgithubissue-gone" {
+			t.Errorf("Stale error card was kept: %v", cards.cards)
+		}
+	}
+}
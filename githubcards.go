@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+)
+
+// cardDiff is the set of changes needed to bring the card server up to date
+type cardDiff struct {
+	adds    []*pb.Card
+	updates []*pb.Card
+	deletes []*pb.Card
+}
+
+// sameCard compares the parts of a card we control; priority is left out
+// since it moves with the age of the issue, and is refreshed by the daily rescore
+func sameCard(a, b *pb.Card) bool {
+	return a.Text == b.Text && a.Channel == b.Channel
+}
+
+// diffCards works out how to move from the current cards to the desired ones,
+// only looking at current cards with the given hash prefix. Forced cards are
+// also updated if their priority has changed.
+func diffCards(prefix string, current, desired []*pb.Card, forced map[string]bool) cardDiff {
+	diff := cardDiff{}
+
+	existing := make(map[string]*pb.Card)
+	for _, card := range current {
+		if strings.HasPrefix(card.Hash, prefix) {
+			existing[card.Hash] = card
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, card := range desired {
+		seen[card.Hash] = true
+		if old, ok := existing[card.Hash]; !ok {
+			diff.adds = append(diff.adds, card)
+		} else if !sameCard(old, card) || (forced[card.Hash] && old.Priority != card.Priority) {
+			diff.updates = append(diff.updates, card)
+		}
+	}
+
+	for _, card := range current {
+		if strings.HasPrefix(card.Hash, prefix) && !seen[card.Hash] {
+			diff.deletes = append(diff.deletes, card)
+		}
+	}
+
+	return diff
+}
+
+// applyDiff pushes a card diff to the card server
+func (b *GithubBridge) applyDiff(ctx context.Context, diff cardDiff) error {
+	for _, card := range append(diff.deletes, diff.updates...) {
+		_, err := b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: card.Hash})
+		if err != nil {
+			return err
+		}
+	}
+
+	toAdd := append(diff.adds, diff.updates...)
+	if len(toAdd) > 0 {
+		_, err := b.cards.AddCards(ctx, &pb.CardList{Cards: toAdd})
+		if err != nil {
+			return err
+		}
+	}
+
+	b.cardsAdded += len(diff.adds)
+	b.cardsUpdated += len(diff.updates)
+	b.cardsDeleted += len(diff.deletes)
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+)
+
+func TestDiffCards(t *testing.T) {
+	current := []*pb.Card{
+		&pb.Card{Hash: "githubissue-same", Text: "same"},
+		&pb.Card{Hash: "githubissue-changed", Text: "before"},
+		&pb.Card{Hash: "githubissue-gone", Text: "gone"},
+		&pb.Card{Hash: "addgithubissue-blah-Home", Text: "title|body"},
+	}
+	desired := []*pb.Card{
+		&pb.Card{Hash: "githubissue-same", Text: "same", Priority: 10},
+		&pb.Card{Hash: "githubissue-changed", Text: "after"},
+		&pb.Card{Hash: "githubissue-new", Text: "new"},
+	}
+
+	diff := diffCards("githubissue-", current, desired, map[string]bool{})
+
+	if len(diff.adds) != 1 || diff.adds[0].Hash != "githubissue-new" {
+		t.Errorf("Bad adds: %v", diff.adds)
+	}
+	if len(diff.updates) != 1 || diff.updates[0].Hash != "githubissue-changed" {
+		t.Errorf("Bad updates: %v", diff.updates)
+	}
+	if len(diff.deletes) != 1 || diff.deletes[0].Hash != "githubissue-gone" {
+		t.Errorf("Bad deletes: %v", diff.deletes)
+	}
+
+	diff = diffCards("githubissue-", current, desired, map[string]bool{"githubissue-same": true})
+	if len(diff.updates) != 2 {
+		t.Errorf("Forced card was not updated: %v", diff.updates)
+	}
+}
+
+func TestPassoverLeavesUnchangedCards(t *testing.T) {
+	s := InitTest()
+	_, err := s.syncIssues(context.Background())
+	if err != nil {
+		t.Fatalf("Unable to sync: %v", err)
+	}
+	issues := s.GetIssues()
+	cards := &testCardClient{cards: issues.Cards}
+	s.cards = cards
+	s.mirror.LastSync = 1537574400
+
+	err = s.passover()
+	if err != nil {
+		t.Fatalf("Error running passover: %v", err)
+	}
+
+	// One new issue card plus the three pull request and two notification cards
+	if s.cardsAdded != 6 || s.cardsUpdated != 0 || s.cardsDeleted != 1 {
+		t.Errorf("Wrong changes made: %v, %v, %v", s.cardsAdded, s.cardsUpdated, s.cardsDeleted)
+	}
+
+	for _, d := range cards.deletes {
+		if d.HashPrefix == "githubissue" {
+			t.Errorf("Cards were deleted by prefix: %v", d)
+		}
+	}
+}
+
+func TestPassoverCardFailure(t *testing.T) {
+	s := InitTest()
+	s.cards = &testCardClient{fail: true}
+
+	err := s.passover()
+	if err == nil {
+		t.Errorf("Card failure did not fail passover")
+	}
+}
+
+func TestPassoverDailyRescore(t *testing.T) {
+	s := InitTest()
+	_, err := s.syncIssues(context.Background())
+	if err != nil {
+		t.Fatalf("Unable to sync: %v", err)
+	}
+	issues := s.GetIssues()
+	for _, card := range issues.Cards {
+		card.Priority = 1
+	}
+	s.cards = &testCardClient{cards: issues.Cards}
+	s.mirror.LastSync = 1537574400
+
+	s.lastRescore = time.Now()
+	err = s.passover()
+	if err != nil || s.cardsUpdated != 0 {
+		t.Fatalf("Cards were rescored early: %v, %v", s.cardsUpdated, err)
+	}
+
+	s.lastRescore = time.Now().Add(-rescoreInterval)
+	s.mirror.LastSync = 1537574400
+	err = s.passover()
+	if err != nil || s.cardsUpdated == 0 {
+		t.Errorf("Cards were not rescored: %v, %v", s.cardsUpdated, err)
+	}
+}
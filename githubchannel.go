@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// CHANNELKEY the rules for routing issues to card channels
+	CHANNELKEY = "/github.com/brotherlogic/githubcard/channels"
+)
+
+// validateChannels checks that every rule points at a real channel and has a usable pattern
+func validateChannels(config *pbgh.ChannelConfig) error {
+	for _, rule := range config.GetRules() {
+		if _, ok := pb.Card_Channel_value[rule.GetChannel()]; !ok {
+			return fmt.Errorf("Unknown channel %v", rule.GetChannel())
+		}
+		if _, err := regexp.Compile(rule.GetTitlePattern()); err != nil {
+			return fmt.Errorf("Bad title pattern %v: %v", rule.GetTitlePattern(), err)
+		}
+	}
+	return nil
+}
+
+func ruleMatches(rule *pbgh.ChannelRule, issue *pbgh.Issue) bool {
+	if rule.GetPullRequests() != issue.GetPullRequest() {
+		return false
+	}
+	if len(rule.GetRepo()) > 0 && rule.GetRepo() != issue.GetService() {
+		return false
+	}
+	if len(rule.GetAssignee()) > 0 && rule.GetAssignee() != issue.GetAssignee() {
+		return false
+	}
+	if len(rule.GetLabel()) > 0 {
+		found := false
+		for _, label := range issue.GetLabels() {
+			found = found || label == rule.GetLabel()
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(rule.GetTitlePattern()) > 0 {
+		matched, err := regexp.MatchString(rule.GetTitlePattern(), issue.GetTitle())
+		if err != nil || !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// channelFor picks the card channel for an issue, the first matching rule wins
+func (b *GithubBridge) channelFor(issue *pbgh.Issue) pb.Card_Channel {
+	for _, rule := range b.channels.GetRules() {
+		if ruleMatches(rule, issue) {
+			return pb.Card_Channel(pb.Card_Channel_value[rule.GetChannel()])
+		}
+	}
+	return pb.Card_ISSUES
+}
+
+func (b *GithubBridge) readChannels(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, CHANNELKEY, &pbgh.ChannelConfig{})
+	if err != nil {
+		return err
+	}
+	b.channels = data.(*pbgh.ChannelConfig)
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+// otherChannel finds a channel that isn't the default
+func otherChannel() string {
+	for _, name := range pbc.Card_Channel_name {
+		if name != "ISSUES" {
+			return name
+		}
+	}
+	return "ISSUES"
+}
+
+func TestChannelFor(t *testing.T) {
+	s := InitTest()
+	other := otherChannel()
+	s.channels = &pb.ChannelConfig{Rules: []*pb.ChannelRule{
+		&pb.ChannelRule{Repo: "crasher", TitlePattern: "(?i)crash", Channel: other},
+		&pb.ChannelRule{Label: "music", Channel: other},
+	}}
+
+	if c := s.channelFor(&pb.Issue{Service: "crasher", Title: "CRASH REPORT"}); c.String() != other {
+		t.Errorf("Crash went to the wrong channel: %v", c)
+	}
+	if c := s.channelFor(&pb.Issue{Service: "crasher", Title: "Feature idea", Labels: []string{"bug", "music"}}); c.String() != other {
+		t.Errorf("Labelled issue went to the wrong channel: %v", c)
+	}
+	if c := s.channelFor(&pb.Issue{Service: "crasher", Title: "Feature idea"}); c != pbc.Card_ISSUES {
+		t.Errorf("Unmatched issue went to the wrong channel: %v", c)
+	}
+}
+
+func TestSetChannels(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.SetChannels(context.Background(), &pb.ChannelConfig{Rules: []*pb.ChannelRule{&pb.ChannelRule{Channel: "MADEUP"}}})
+	if err == nil {
+		t.Errorf("Unknown channel was accepted")
+	}
+
+	_, err = s.SetChannels(context.Background(), &pb.ChannelConfig{Rules: []*pb.ChannelRule{&pb.ChannelRule{TitlePattern: "(", Channel: otherChannel()}}})
+	if err == nil {
+		t.Errorf("Bad pattern was accepted")
+	}
+
+	_, err = s.SetChannels(context.Background(), &pb.ChannelConfig{Rules: []*pb.ChannelRule{&pb.ChannelRule{Assignee: "brotherlogic", Channel: otherChannel()}}})
+	if err != nil {
+		t.Fatalf("Unable to set channels: %v", err)
+	}
+
+	s.channels = &pb.ChannelConfig{}
+	err = s.readChannels(context.Background())
+	if err != nil || len(s.channels.Rules) != 1 {
+		t.Errorf("Channels were not stored: %v, %v", s.channels, err)
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"golang.org/x/net/context"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// FILINGKEY the templates and routes used when filing issues
+	FILINGKEY = "/github.com/brotherlogic/githubcard/filing"
+)
+
+// validateFiling checks that every template parses and every route points at one
+func validateFiling(config *pbgh.FilingConfig) error {
+	names := make(map[string]bool)
+	for _, t := range config.GetTemplates() {
+		if len(t.GetName()) == 0 || names[t.GetName()] {
+			return fmt.Errorf("Template name %v is missing or repeated", t.GetName())
+		}
+		names[t.GetName()] = true
+
+		for _, text := range []string{t.GetTitle(), t.GetBody()} {
+			if _, err := template.New(t.GetName()).Parse(text); err != nil {
+				return fmt.Errorf("Bad template %v: %v", t.GetName(), err)
+			}
+		}
+	}
+
+	for _, route := range config.GetRoutes() {
+		if len(route.GetTemplate()) > 0 && !names[route.GetTemplate()] {
+			return fmt.Errorf("Route for %v uses unknown template %v", route.GetService(), route.GetTemplate())
+		}
+		if err := validateRedactions(route.GetRedactions()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// routeFor picks the filing route for an issue, the first matching route wins
+func (b *GithubBridge) routeFor(issue *pbgh.Issue) *pbgh.Route {
+	for _, route := range b.filing.GetRoutes() {
+		if len(route.GetService()) == 0 || route.GetService() == issue.GetService() {
+			return route
+		}
+	}
+	return nil
+}
+
+func (b *GithubBridge) findTemplate(name string) *pbgh.Template {
+	for _, t := range b.filing.GetTemplates() {
+		if t.GetName() == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func execute(name, text string, issue *pbgh.Issue) (string, error) {
+	t, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	out := &bytes.Buffer{}
+	err = t.Execute(out, issue)
+	if err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// renderIssue runs the issue through the sanitiser and template for its route, if it has them
+func (b *GithubBridge) renderIssue(issue *pbgh.Issue) error {
+	route := b.routeFor(issue)
+	if route == nil {
+		return nil
+	}
+	if route.GetSanitise() {
+		sanitiseIssue(issue)
+	}
+	if len(route.GetTemplate()) == 0 {
+		return nil
+	}
+	t := b.findTemplate(route.GetTemplate())
+	if t == nil {
+		return fmt.Errorf("Unknown template %v", route.GetTemplate())
+	}
+
+	title, err := execute(t.GetName(), t.GetTitle(), issue)
+	if err != nil {
+		return err
+	}
+	body, err := execute(t.GetName(), t.GetBody(), issue)
+	if err != nil {
+		return err
+	}
+
+	// An empty template leaves that part of the issue alone
+	if len(t.GetTitle()) > 0 {
+		issue.Title = title
+	}
+	if len(t.GetBody()) > 0 {
+		issue.Body = body
+	}
+	return nil
+}
+
+func (b *GithubBridge) readFiling(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, FILINGKEY, &pbgh.FilingConfig{})
+	if err != nil {
+		return err
+	}
+	b.filing = data.(*pbgh.FilingConfig)
+	return nil
+}
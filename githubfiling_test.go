@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func filingTest() *GithubBridge {
+	s := InitTest()
+	s.filing = &pb.FilingConfig{
+		Templates: []*pb.Template{&pb.Template{
+			Name:  "crash",
+			Title: "{{.Service}} crashed on {{.Host}}",
+			Body:  "Version: {{.Version}}\nError: {{.Error}}\n\n{{.Stack}}\n{{range $k, $v := .Metadata}}{{$k}}={{$v}}\n{{end}}",
+		}},
+		Routes: []*pb.Route{&pb.Route{Service: "crasher", Template: "crash"}, &pb.Route{Service: "Home"}},
+	}
+	return s
+}
+
+func TestRenderIssue(t *testing.T) {
+	s := filingTest()
+	issue := &pb.Issue{Service: "crasher", Title: "Crash", Host: "runner", Version: "1.2", Error: "nil pointer", Stack: "main.go:12", Metadata: map[string]string{"a": "b"}}
+
+	err := s.renderIssue(issue)
+	if err != nil {
+		t.Fatalf("Error rendering: %v", err)
+	}
+
+	if issue.Title != "crasher crashed on runner" || issue.Body != "Version: 1.2\nError: nil pointer\n\nmain.go:12\na=b\n" {
+		t.Errorf("Bad render: %v / %v", issue.Title, issue.Body)
+	}
+}
+
+func TestRenderIssueWithoutTemplate(t *testing.T) {
+	s := filingTest()
+	issue := &pb.Issue{Service: "Home", Title: "Testing", Body: "Raw"}
+
+	err := s.renderIssue(issue)
+	if err != nil || issue.Title != "Testing" || issue.Body != "Raw" {
+		t.Errorf("Issue was changed: %v (%v)", issue, err)
+	}
+}
+
+func TestAddRenderedIssue(t *testing.T) {
+	s := filingTest()
+	s.filing.Routes[0].Service = "Home"
+
+	issue, err := s.AddIssue(context.Background(), &pb.Issue{Service: "Home", Title: "Crash", Host: "runner"})
+	if err != nil {
+		t.Fatalf("Error adding issue: %v", err)
+	}
+
+	if issue.Title != "Home crashed on runner" || issue.Number != 494 {
+		t.Errorf("Issue was not rendered: %v", issue)
+	}
+}
+
+func TestSetFilingBadConfig(t *testing.T) {
+	s := InitTest()
+
+	for _, config := range []*pb.FilingConfig{
+		&pb.FilingConfig{Templates: []*pb.Template{&pb.Template{Name: "broken", Body: "{{.Service"}}},
+		&pb.FilingConfig{Templates: []*pb.Template{&pb.Template{Name: "a"}, &pb.Template{Name: "a"}}},
+		&pb.FilingConfig{Routes: []*pb.Route{&pb.Route{Service: "Home", Template: "missing"}}},
+	} {
+		_, err := s.SetFiling(context.Background(), config)
+		if err == nil {
+			t.Errorf("Bad config was accepted: %v", config)
+		}
+	}
+}
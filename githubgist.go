@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// Github rejects issue bodies longer than this
+	maxBodyLength = 65536
+
+	// How much of an oversized body makes it into the issue
+	excerptLength = 4096
+)
+
+// gistFile is a single file in a gist
+type gistFile struct {
+	Content string `json:"content"`
+}
+
+// gistPayload for creating a gist
+type gistPayload struct {
+	Description string              `json:"description"`
+	Public      bool                `json:"public"`
+	Files       map[string]gistFile `json:"files"`
+}
+
+// createGist uploads the files as a secret gist, returning its url
+func (b *GithubBridge) createGist(ctx context.Context, description string, files map[string]string) (string, error) {
+	url, err := b.postGist(description, files)
+	b.auditTarget(ctx, selfCaller, "create_gist", url, err)
+	return url, err
+}
+
+func (b *GithubBridge) postGist(description string, files map[string]string) (string, error) {
+	payload := gistPayload{Description: description, Files: make(map[string]gistFile)}
+	for name, content := range files {
+		payload.Files[name] = gistFile{Content: content}
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := b.postURL("https://api.github.com/gists", string(data))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != 200 && resp.StatusCode != 201 {
+		return "", fmt.Errorf("%v returned from github: %v", resp.StatusCode, string(body))
+	}
+
+	gist := make(map[string]interface{})
+	err = json.Unmarshal(body, &gist)
+	if err != nil {
+		return "", err
+	}
+	url, ok := gist["html_url"].(string)
+	if !ok {
+		return "", fmt.Errorf("No url for gist: %v", string(body))
+	}
+
+	b.gists++
+	return url, nil
+}
+
+// excerpt cuts text down to at most n bytes without splitting a character
+func excerpt(text string, n int) string {
+	if len(text) <= n {
+		return text
+	}
+	for n > 0 && !utf8.RuneStart(text[n]) {
+		n--
+	}
+	return text[:n]
+}
+
+// shrinkBody moves an oversized body into a gist, leaving an excerpt and a link
+func (b *GithubBridge) shrinkBody(ctx context.Context, title, body string) string {
+	if len(body) <= maxBodyLength {
+		return body
+	}
+
+	url, err := b.createGist(ctx, title, map[string]string{"body.txt": body})
+	if err != nil {
+		b.Log(fmt.Sprintf("Unable to upload body of %v: %v", title, err))
+		return fmt.Sprintf("%v\n\n... truncated from %v bytes, the full body could not be uploaded", excerpt(body, excerptLength), len(body))
+	}
+	return fmt.Sprintf("%v\n\n... truncated from %v bytes, full body at %v", excerpt(body, excerptLength), len(body), url)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestExcerptKeepsCharacters(t *testing.T) {
+	text := excerpt("aé", 2)
+	if text != "a" {
+		t.Errorf("Bad excerpt: %q", text)
+	}
+}
+
+func TestShortBodyUnchanged(t *testing.T) {
+	s := InitTest()
+	if s.shrinkBody(context.Background(), "Title", "Body") != "Body" || s.gists != 0 {
+		t.Errorf("Short body was changed")
+	}
+}
+
+func TestOversizedBody(t *testing.T) {
+	s := InitTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+
+	_, err := s.AddIssue(context.Background(), &pb.Issue{Title: "Big log", Service: "Home", Body: strings.Repeat("log line\n", 10000)})
+	if err != nil {
+		t.Fatalf("Error adding issue: %v", err)
+	}
+
+	if len(sent) != 2 || !strings.Contains(sent[0], "\"public\":false") {
+		t.Fatalf("Body was not uploaded: %v", len(sent))
+	}
+	if len(sent[1]) > maxBodyLength || !strings.Contains(sent[1], "https://gist.github.com/aa5a315d61ae9438b18d") {
+		t.Errorf("Issue was not shrunk: %v", len(sent[1]))
+	}
+}
+
+func TestOversizedBodyExistingIssue(t *testing.T) {
+	s := InitTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+
+	_, err := s.AddIssueLocal(context.Background(), "brotherlogic", "Home", "CRASH REPORT", strings.Repeat("log line\n", 10000))
+	if err != errIssueExists {
+		t.Fatalf("Existing issue was filed: %v", err)
+	}
+	if len(sent) != 0 || s.gists != 0 {
+		t.Errorf("Body was uploaded for an existing issue: %v", len(sent))
+	}
+}
+
+func TestOversizedBodyGistFails(t *testing.T) {
+	s := InitTest()
+	s.accessCode = "broke"
+
+	body := s.shrinkBody(context.Background(), "Big log", strings.Repeat("log line\n", 10000))
+	if len(body) > maxBodyLength || !strings.Contains(body, "could not be uploaded") {
+		t.Errorf("Body was not truncated: %v", len(body))
+	}
+}
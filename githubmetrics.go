@@ -0,0 +1,93 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+)
+
+var (
+	githubRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "githubcard_github_requests_total",
+		Help: "Calls made to the github API",
+	}, []string{"method", "endpoint", "code"})
+	githubLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "githubcard_github_request_seconds",
+		Help:    "Latency of calls to the github API",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "endpoint"})
+	rateLimitRemaining = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "githubcard_github_rate_limit_remaining",
+		Help: "Requests left in the current github rate limit window",
+	})
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "githubcard_rpc_requests_total",
+		Help: "RPCs received",
+	}, []string{"method", "caller"})
+	queueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "githubcard_sticky_queue_depth",
+		Help: "Sticky issues waiting to be filed",
+	})
+	dedupHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "githubcard_dedup_hits_total",
+		Help: "Requests dropped or merged as duplicates",
+	}, []string{"kind"})
+	lastPass = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "githubcard_last_successful_pass_seconds",
+		Help: "When passover last ran cleanly",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(githubRequests, githubLatency, rateLimitRemaining, rpcRequests, queueDepth, dedupHits, lastPass)
+}
+
+// Path pieces that would explode the number of endpoint labels
+var endpointParts = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile("^/repos/[^/]+/[^/]+"), "/repos/:owner/:repo"},
+	{regexp.MustCompile("/commits/[^/]+"), "/commits/:ref"},
+	{regexp.MustCompile("/threads/[^/]+"), "/threads/:id"},
+	{regexp.MustCompile("/[0-9]+(/|$)"), "/:number$1"},
+}
+
+// endpointFor turns a github url into a low cardinality label
+func endpointFor(urlv string) string {
+	u, err := url.Parse(urlv)
+	if err != nil {
+		return "unknown"
+	}
+
+	path := u.Path
+	for _, part := range endpointParts {
+		path = part.pattern.ReplaceAllString(path, part.replacement)
+	}
+	return path
+}
+
+// recordRequest tracks a call to github
+func recordRequest(method, urlv string, start time.Time, resp *http.Response, err error) {
+	endpoint := endpointFor(urlv)
+	githubLatency.WithLabelValues(method, endpoint).Observe(time.Now().Sub(start).Seconds())
+
+	if err != nil {
+		githubRequests.WithLabelValues(method, endpoint, "error").Inc()
+		return
+	}
+	githubRequests.WithLabelValues(method, endpoint, strconv.Itoa(resp.StatusCode)).Inc()
+
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		rateLimitRemaining.Set(float64(remaining))
+	}
+}
+
+func countRPC(ctx context.Context, method string) {
+	rpcRequests.WithLabelValues(method, callerFor(ctx)).Inc()
+}
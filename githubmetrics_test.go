@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestEndpointFor(t *testing.T) {
+	for in, expected := range map[string]string{
+		"https://api.github.com/repos/brotherlogic/Home/issues/12?access_token=token":                "/repos/:owner/:repo/issues/:number",
+		"https://api.github.com/repos/brotherlogic/Home/issues/12/comments":                          "/repos/:owner/:repo/issues/:number/comments",
+		"https://api.github.com/repos/brotherlogic/Home/commits/master/status":                       "/repos/:owner/:repo/commits/:ref/status",
+		"https://api.github.com/notifications/threads/1001":                                          "/notifications/threads/:id",
+		"https://api.github.com/search/issues?q=is%3Aopen":                                           "/search/issues",
+		"https://api.github.com/issues?state=all&filter=all&per_page=100&since=2018-09-22T00:00:00Z": "/issues",
+	} {
+		if endpointFor(in) != expected {
+			t.Errorf("Bad endpoint for %v: %v", in, endpointFor(in))
+		}
+	}
+}
+
+func TestGithubRequestsCounted(t *testing.T) {
+	s := InitTest()
+	before := testutil.ToFloat64(githubRequests.WithLabelValues("GET", "/repos/:owner/:repo/issues/:number", "200"))
+
+	_, err := s.Get(context.Background(), &pb.Issue{Service: "Home", Number: 12})
+	if err != nil {
+		t.Fatalf("Error getting issue: %v", err)
+	}
+
+	after := testutil.ToFloat64(githubRequests.WithLabelValues("GET", "/repos/:owner/:repo/issues/:number", "200"))
+	if after-before != 1 {
+		t.Errorf("Request was not counted: %v -> %v", before, after)
+	}
+	if testutil.ToFloat64(rpcRequests.WithLabelValues("Get", "unknown")) == 0 {
+		t.Errorf("RPC was not counted")
+	}
+}
+
+func TestDedupHitsCounted(t *testing.T) {
+	s := InitTest()
+	before := testutil.ToFloat64(dedupHits.WithLabelValues("title"))
+
+	s.AddIssue(context.Background(), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+	s.AddIssue(context.Background(), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+
+	if testutil.ToFloat64(dedupHits.WithLabelValues("title"))-before != 1 {
+		t.Errorf("Duplicate was not counted")
+	}
+}
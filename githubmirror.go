@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// MIRRORKEY the local copy of our open issues
+	MIRRORKEY = "/github.com/brotherlogic/githubcard/mirror"
+)
+
+// mirrorIssue applies an issue to the mirror, returning true if anything changed
+func (b *GithubBridge) mirrorIssue(issue *pbgh.Issue) bool {
+	b.mirrorMutex.Lock()
+	defer b.mirrorMutex.Unlock()
+
+	for i, existing := range b.mirror.Issues {
+		if existing.GetUrl() == issue.GetUrl() {
+			if issue.GetState() == pbgh.Issue_CLOSED {
+				b.mirror.Issues = append(b.mirror.Issues[:i], b.mirror.Issues[i+1:]...)
+				return true
+			}
+			if existing.GetUpdatedAt() == issue.GetUpdatedAt() && existing.GetUpdatedAt() > 0 {
+				return false
+			}
+			b.mirror.Issues[i] = issue
+			return true
+		}
+	}
+
+	if issue.GetState() == pbgh.Issue_CLOSED {
+		return false
+	}
+	b.mirror.Issues = append(b.mirror.Issues, issue)
+	return true
+}
+
+func (b *GithubBridge) saveMirror(ctx context.Context) {
+	b.mirrorMutex.Lock()
+	defer b.mirrorMutex.Unlock()
+	b.KSclient.Save(ctx, MIRRORKEY, b.mirror)
+}
+
+func (b *GithubBridge) readMirror(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, MIRRORKEY, &pbgh.IssueMirror{})
+	if err != nil {
+		return err
+	}
+
+	b.mirrorMutex.Lock()
+	defer b.mirrorMutex.Unlock()
+	b.mirror = data.(*pbgh.IssueMirror)
+	return nil
+}
+
+// nextPage pulls the next page out of a github Link header, without our access token
+func nextPage(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		pieces := strings.Split(part, ";")
+		if len(pieces) < 2 || strings.TrimSpace(pieces[1]) != `rel="next"` {
+			continue
+		}
+
+		next, err := url.Parse(strings.Trim(strings.TrimSpace(pieces[0]), "<>"))
+		if err != nil {
+			return ""
+		}
+		query := next.Query()
+		query.Del("access_token")
+		next.RawQuery = query.Encode()
+		return next.String()
+	}
+	return ""
+}
+
+// syncIssues pulls everything that's changed since the last sync into the mirror
+func (b *GithubBridge) syncIssues(ctx context.Context) ([]*pbgh.Issue, error) {
+	syncTime := time.Now()
+
+	urlv := "https://api.github.com/issues?state=open&filter=all&per_page=100"
+	if b.mirror.GetLastSync() > 0 {
+		urlv = "https://api.github.com/issues?state=all&filter=all&per_page=100&since=" + time.Unix(b.mirror.GetLastSync(), 0).UTC().Format("2006-01-02T15:04:05Z")
+	}
+
+	//Only move the sync point on once we've seen every page
+	changed := []*pbgh.Issue{}
+	for len(urlv) > 0 {
+		body, next, err := b.visitPage(urlv)
+		if err != nil {
+			return nil, err
+		}
+
+		var data []interface{}
+		err = json.Unmarshal([]byte(body), &data)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range data {
+			issueMap, ok := d.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Bad issue in sync: %v", d)
+			}
+			if _, ok := issueMap["pull_request"]; ok {
+				continue
+			}
+
+			issue, err := issueFromJSON("", issueMap)
+			if err != nil {
+				return nil, err
+			}
+			if b.mirrorIssue(issue) {
+				changed = append(changed, issue)
+			}
+		}
+		urlv = next
+	}
+
+	b.mirrorMutex.Lock()
+	b.mirror.LastSync = syncTime.Unix()
+	b.mirrorMutex.Unlock()
+	b.saveMirror(ctx)
+
+	return changed, nil
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestSyncIssues(t *testing.T) {
+	s := InitTest()
+
+	changed, err := s.syncIssues(context.Background())
+	if err != nil {
+		t.Fatalf("Error syncing issues: %v", err)
+	}
+
+	if len(changed) != 2 || len(s.mirror.Issues) != 2 {
+		t.Errorf("Wrong issues synced: %v -> %v", changed, s.mirror)
+	}
+
+	if s.mirror.Issues[0].Service != "crasher" || s.mirror.Issues[0].Number != 15 {
+		t.Errorf("Issue was not read correctly: %v", s.mirror.Issues[0])
+	}
+
+	if s.mirror.LastSync == 0 {
+		t.Errorf("Sync time was not recorded")
+	}
+}
+
+func TestSyncIssuesSince(t *testing.T) {
+	s := InitTest()
+	s.mirror = &pb.IssueMirror{
+		LastSync: 1537574400,
+		Issues:   []*pb.Issue{&pb.Issue{Service: "crasher", Number: 15, Url: "https://api.github.com/repos/brotherlogic/crasher/issues/15", UpdatedAt: 1537437600}},
+	}
+
+	changed, err := s.syncIssues(context.Background())
+	if err != nil {
+		t.Fatalf("Error syncing issues: %v", err)
+	}
+
+	if len(changed) != 2 {
+		t.Errorf("Wrong number of changes: %v", changed)
+	}
+
+	if len(s.mirror.Issues) != 1 || s.mirror.Issues[0].Number != 494 {
+		t.Errorf("Mirror was not updated: %v", s.mirror)
+	}
+}
+
+// pagedGetter splits responses into pages with a Link header
+type pagedGetter struct {
+	testFileGetter
+	next map[string]string
+	fail map[string]bool
+}
+
+func (httpGetter pagedGetter) Get(url string) (*http.Response, error) {
+	if httpGetter.fail[url] {
+		return failGetter{}.Get(url)
+	}
+	resp, err := httpGetter.testFileGetter.Get(url)
+	if err == nil && len(httpGetter.next[url]) > 0 {
+		resp.Header = http.Header{}
+		resp.Header.Set("Link", "<"+httpGetter.next[url]+">; rel=\"next\", <https://api.github.com/issues?page=5>; rel=\"last\"")
+	}
+	return resp, err
+}
+
+const (
+	firstPage  = "https://api.github.com/issues?state=open&filter=all&per_page=100&access_token=token"
+	secondPage = "https://api.github.com/issues?filter=all&page=2&per_page=100&state=open&access_token=token"
+)
+
+func TestSyncIssuesPaged(t *testing.T) {
+	s := InitTest()
+	s.getter = pagedGetter{next: map[string]string{firstPage: "https://api.github.com/issues?state=open&filter=all&per_page=100&page=2&access_token=token"}}
+
+	changed, err := s.syncIssues(context.Background())
+	if err != nil {
+		t.Fatalf("Error syncing issues: %v", err)
+	}
+
+	if len(changed) != 3 || len(s.mirror.Issues) != 3 || s.mirror.Issues[2].Service != "recordgetter" {
+		t.Errorf("Second page was not synced: %v", s.mirror)
+	}
+}
+
+func TestSyncIssuesPageFails(t *testing.T) {
+	s := InitTest()
+	s.getter = pagedGetter{
+		next: map[string]string{firstPage: "https://api.github.com/issues?state=open&filter=all&per_page=100&page=2"},
+		fail: map[string]bool{secondPage: true},
+	}
+
+	_, err := s.syncIssues(context.Background())
+	if err == nil {
+		t.Fatalf("Sync with a missing page succeeded")
+	}
+
+	if s.mirror.LastSync != 0 {
+		t.Errorf("Sync time moved on without every page: %v", s.mirror.LastSync)
+	}
+}
+
+func TestMirrorIssueUnchanged(t *testing.T) {
+	s := InitTest()
+	issue := &pb.Issue{Url: "https://api.github.com/repos/brotherlogic/Home/issues/494", UpdatedAt: 100}
+
+	if !s.mirrorIssue(issue) {
+		t.Errorf("New issue was not added")
+	}
+	if s.mirrorIssue(&pb.Issue{Url: issue.Url, UpdatedAt: 100}) {
+		t.Errorf("Unchanged issue was reported as changed")
+	}
+}
+
+func TestPassoverMatchesMirror(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubissue-https://api.github.com/repos/brotherlogic/crasher/issues/15"}, &pbc.Card{Hash: "githubissue-https://api.github.com/repos/brotherlogic/other/issues/1"}}}
+	s.cards = cards
+	s.mirror = &pb.IssueMirror{
+		LastSync: 1537574400,
+		Issues:   []*pb.Issue{&pb.Issue{Service: "crasher", Number: 15, Url: "https://api.github.com/repos/brotherlogic/crasher/issues/15", UpdatedAt: 1537437600}},
+	}
+
+	err := s.passover()
+	if err != nil {
+		t.Fatalf("Error running passover: %v", err)
+	}
+
+	issueCards := []*pbc.Card{}
+	for _, c := range cards.cards {
+		if strings.HasPrefix(c.Hash, "githubissue-") {
+			issueCards = append(issueCards, c)
+		}
+	}
+	if len(issueCards) != 1 || issueCards[0].Hash != "githubissue-https://api.github.com/repos/brotherlogic/Home/issues/494" {
+		t.Errorf("Cards were not updated correctly: %v", issueCards)
+	}
+}
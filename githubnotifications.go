@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// NOTIFICATIONKEY the notification threads we have cards up for
+	NOTIFICATIONKEY = "/github.com/brotherlogic/githubcard/notifications"
+
+	// Cards for unread notification threads
+	notificationCardPrefix = "githubnotification-"
+)
+
+// Things asked of us directly come before general chatter
+var notificationPriority = map[string]int32{
+	"review_requested": 3000,
+	"mention":          2000,
+	"team_mention":     1500,
+	"assign":           2000,
+}
+
+// notification is an unread thread from the github inbox
+type notification struct {
+	ID      string
+	Reason  string
+	Title   string
+	Repo    string
+	Subject string
+}
+
+// getNotifications reads the unread threads in our inbox
+func (b *GithubBridge) getNotifications() ([]*notification, error) {
+	body, err := b.visitURL("https://api.github.com/notifications")
+	if err != nil {
+		return nil, err
+	}
+
+	var data []interface{}
+	err = json.Unmarshal([]byte(body), &data)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := []*notification{}
+	for _, d := range data {
+		thread := d.(map[string]interface{})
+		n := &notification{}
+		n.ID, _ = thread["id"].(string)
+		n.Reason, _ = thread["reason"].(string)
+		if subject, ok := thread["subject"].(map[string]interface{}); ok {
+			n.Title, _ = subject["title"].(string)
+			n.Subject, _ = subject["url"].(string)
+		}
+		if repo, ok := thread["repository"].(map[string]interface{}); ok {
+			n.Repo, _ = repo["name"].(string)
+		}
+		if len(n.ID) == 0 {
+			return nil, fmt.Errorf("Notification has no id: %v", thread)
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+// notificationCard builds the card for a notification thread
+func notificationCard(n *notification) *pb.Card {
+	card := &pb.Card{}
+	card.Text = n.Reason + ": " + n.Title + "\n" + n.Repo + "\n\n" + n.Subject
+	card.Hash = notificationCardPrefix + n.ID
+	card.Channel = pb.Card_ISSUES
+	card.Priority = 1000
+	if priority, ok := notificationPriority[n.Reason]; ok {
+		card.Priority = priority
+	}
+	return card
+}
+
+// markRead marks a notification thread as read on github
+func (b *GithubBridge) markRead(id string) error {
+	return checkResponse(b.patchURL("https://api.github.com/notifications/threads/"+id, ""))
+}
+
+// updateNotificationCards brings the notification cards in line with the inbox,
+// marking threads read whose cards have been dismissed
+func (b *GithubBridge) updateNotificationCards(ctx context.Context, current []*pb.Card) error {
+	existing := make(map[string]bool)
+	ours := false
+	for _, card := range current {
+		existing[card.Hash] = true
+		ours = ours || strings.HasPrefix(card.Hash, "github")
+	}
+
+	// Marking read can't be undone, so if none of our cards are left the
+	// cardserver has lost them rather than them all being dismissed
+	dismissed := make(map[string]bool)
+	for _, id := range b.notifications.GetThreads() {
+		if ours && !existing[notificationCardPrefix+id] {
+			err := b.markRead(id)
+			b.auditTarget(ctx, selfCaller, "mark_read", "thread/"+id, err)
+			if err != nil {
+				b.Log(fmt.Sprintf("Unable to mark %v as read: %v", id, err))
+				continue
+			}
+			dismissed[id] = true
+		}
+	}
+
+	notifications, err := b.getNotifications()
+	if err != nil {
+		return err
+	}
+
+	cards := []*pb.Card{}
+	threads := []string{}
+	for _, n := range notifications {
+		if !dismissed[n.ID] {
+			cards = append(cards, notificationCard(n))
+			threads = append(threads, n.ID)
+		}
+	}
+
+	err = b.applyDiff(ctx, diffCards(notificationCardPrefix, current, cards, map[string]bool{}))
+	if err != nil {
+		return err
+	}
+
+	b.notifications.Threads = threads
+	return b.KSclient.Save(ctx, NOTIFICATIONKEY, b.notifications)
+}
+
+func (b *GithubBridge) readNotifications(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, NOTIFICATIONKEY, &pbgh.NotificationState{})
+	if err != nil {
+		return err
+	}
+	b.notifications = data.(*pbgh.NotificationState)
+	return nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestGetNotifications(t *testing.T) {
+	s := InitTest()
+
+	notifications, err := s.getNotifications()
+	if err != nil {
+		t.Fatalf("Error getting notifications: %v", err)
+	}
+
+	if len(notifications) != 2 || notifications[0].Reason != "review_requested" || notifications[1].Repo != "crasher" {
+		t.Errorf("Bad notifications: %v", notifications)
+	}
+
+	if notificationCard(notifications[0]).Priority <= notificationCard(notifications[1]).Priority {
+		t.Errorf("Review request should outrank a mention")
+	}
+}
+
+func TestDismissedNotificationMarkedRead(t *testing.T) {
+	s := InitTest()
+	s.notifications = &pb.NotificationState{Threads: []string{"1001", "1003"}}
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubnotification-1001"}}}
+	s.cards = cards
+
+	err := s.updateNotificationCards(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error updating notification cards: %v", err)
+	}
+
+	hashes := make(map[string]bool)
+	for _, card := range cards.cards {
+		hashes[card.Hash] = true
+	}
+	if len(cards.cards) != 2 || !hashes["githubnotification-1001"] || !hashes["githubnotification-1002"] {
+		t.Errorf("Notification cards were not updated: %v", cards.cards)
+	}
+
+	if len(s.notifications.GetThreads()) != 2 || s.notifications.GetThreads()[0] != "1001" || s.notifications.GetThreads()[1] != "1002" {
+		t.Errorf("Bad notification state: %v", s.notifications)
+	}
+}
+
+func TestDismissedNotificationFailsToMarkRead(t *testing.T) {
+	s := InitTest()
+	s.notifications = &pb.NotificationState{Threads: []string{"1002"}}
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubnotification-1001"}}}
+	s.cards = cards
+
+	err := s.updateNotificationCards(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error updating notification cards: %v", err)
+	}
+
+	events := s.auditEvents(&pb.AuditRequest{Rpc: "mark_read"})
+	if len(events) != 1 || events[0].GetTarget() != "thread/1002" {
+		t.Errorf("Mark read was not audited against the thread: %v", events)
+	}
+
+	// We couldn't mark the thread read, so it comes back as unread
+	if len(cards.cards) != 2 {
+		t.Errorf("Notification cards were not updated: %v", cards.cards)
+	}
+}
+
+func TestLostCardsNotMarkedRead(t *testing.T) {
+	s := InitTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+	s.notifications = &pb.NotificationState{Threads: []string{"1001", "1002"}}
+	cards := &testCardClient{}
+	s.cards = cards
+
+	err := s.updateNotificationCards(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error updating notification cards: %v", err)
+	}
+
+	if len(sent) != 0 || len(s.auditEvents(&pb.AuditRequest{Rpc: "mark_read"})) != 0 {
+		t.Errorf("Threads were marked read when the cardserver came back empty: %v", sent)
+	}
+	if len(cards.cards) != 2 {
+		t.Errorf("Notification cards were not restored: %v", cards.cards)
+	}
+}
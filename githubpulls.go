@@ -0,0 +1,217 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// Cards for pull requests which need attention
+	pullCardPrefix = "githubpr-"
+)
+
+// searchIssues runs an issue search, returning the matching items
+func (b *GithubBridge) searchIssues(query string) ([]map[string]interface{}, error) {
+	body, err := b.visitURL("https://api.github.com/search/issues?q=" + url.QueryEscape(query))
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+	err = json.Unmarshal([]byte(body), &data)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := data["items"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Bad search response: %v", body)
+	}
+
+	results := []map[string]interface{}{}
+	for _, item := range items {
+		result, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Bad search result: %v", item)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// pullState works out whether one of our pull requests needs us
+func (b *GithubBridge) pullState(item map[string]interface{}) (pbgh.Issue_PullRequestState, error) {
+	pr, ok := item["pull_request"].(map[string]interface{})
+	if !ok {
+		return pbgh.Issue_NONE, fmt.Errorf("%v is not a pull request", item["url"])
+	}
+	pullURL, ok := pr["url"].(string)
+	if !ok {
+		return pbgh.Issue_NONE, fmt.Errorf("%v has no pull request url", item["url"])
+	}
+	repoURL, ok := item["repository_url"].(string)
+	if !ok {
+		return pbgh.Issue_NONE, fmt.Errorf("%v has no repository url", item["url"])
+	}
+
+	body, err := b.visitURL(pullURL)
+	if err != nil {
+		return pbgh.Issue_NONE, err
+	}
+	var pull map[string]interface{}
+	err = json.Unmarshal([]byte(body), &pull)
+	if err != nil {
+		return pbgh.Issue_NONE, err
+	}
+
+	head, ok := pull["head"].(map[string]interface{})
+	if !ok {
+		return pbgh.Issue_NONE, fmt.Errorf("Bad pull request: %v", body)
+	}
+	sha, ok := head["sha"].(string)
+	if !ok {
+		return pbgh.Issue_NONE, fmt.Errorf("Pull request has no head commit: %v", body)
+	}
+	body, err = b.visitURL(repoURL + "/commits/" + sha + "/status")
+	if err != nil {
+		return pbgh.Issue_NONE, err
+	}
+	var status map[string]interface{}
+	err = json.Unmarshal([]byte(body), &status)
+	if err != nil {
+		return pbgh.Issue_NONE, err
+	}
+
+	switch status["state"] {
+	case "failure", "error":
+		return pbgh.Issue_FAILING, nil
+	case "success":
+		if pull["mergeable_state"] == "clean" {
+			return pbgh.Issue_AWAITING_MERGE, nil
+		}
+	}
+	return pbgh.Issue_NONE, nil
+}
+
+// getPullRequests finds the open pull requests that need our attention
+func (b *GithubBridge) getPullRequests() ([]*pbgh.Issue, error) {
+	pulls := []*pbgh.Issue{}
+
+	reviews, err := b.searchIssues("is:open is:pr review-requested:brotherlogic")
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range reviews {
+		pull, err := issueFromJSON("", item)
+		if err != nil {
+			return nil, err
+		}
+		pull.PullRequest = true
+		pull.PullRequestState = pbgh.Issue_REVIEW_REQUESTED
+		pulls = append(pulls, pull)
+	}
+
+	mine, err := b.searchIssues("is:open is:pr author:brotherlogic")
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range mine {
+		//One broken pull request shouldn't hold up the rest
+		state, err := b.pullState(item)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to get state of %v: %v", item["url"], err))
+			continue
+		}
+
+		if state != pbgh.Issue_NONE {
+			pull, err := issueFromJSON("", item)
+			if err != nil {
+				b.Log(fmt.Sprintf("Bad pull request %v: %v", item["url"], err))
+				continue
+			}
+			pull.PullRequest = true
+			pull.PullRequestState = state
+			pulls = append(pulls, pull)
+		}
+	}
+
+	return pulls, nil
+}
+
+// pullCard builds the card for a pull request
+func (b *GithubBridge) pullCard(pull *pbgh.Issue) *pb.Card {
+	card := &pb.Card{}
+	card.Text = pull.GetTitle() + "\n" + pull.GetPullRequestState().String() + "\n\n" + pull.GetUrl()
+	card.Hash = pullCardPrefix + pull.GetUrl()
+	card.Channel = b.channelFor(pull)
+	card.Priority = b.scoreIssue(pull, time.Now()).GetTotal()
+	return card
+}
+
+// reviewRequested reports if a pull request is waiting on our review
+func reviewRequested(pr map[string]interface{}) bool {
+	reviewers, _ := pr["requested_reviewers"].([]interface{})
+	for _, r := range reviewers {
+		if reviewer, ok := r.(map[string]interface{}); ok && reviewer["login"] == "brotherlogic" {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshPullCard brings the card for a pull request we've had a webhook for up to date
+func (b *GithubBridge) refreshPullCard(ctx context.Context, repo, pr map[string]interface{}, pull *pbgh.Issue) error {
+	//Cards are keyed on the issue url, as search gives us
+	issueURL, ok := pr["issue_url"].(string)
+	if !ok {
+		return fmt.Errorf("Pull request has no issue url: %v", pr)
+	}
+	pull.Url = issueURL
+	pull.PullRequest = true
+
+	if pull.GetState() == pbgh.Issue_OPEN {
+		if reviewRequested(pr) {
+			pull.PullRequestState = pbgh.Issue_REVIEW_REQUESTED
+		} else if user, ok := pr["user"].(map[string]interface{}); ok && user["login"] == "brotherlogic" {
+			pullURL, ok := pr["url"].(string)
+			repoURL, ok2 := repo["url"].(string)
+			if !ok || !ok2 {
+				return fmt.Errorf("Pull request %v has no api urls", issueURL)
+			}
+			state, err := b.pullState(map[string]interface{}{"pull_request": map[string]interface{}{"url": pullURL}, "repository_url": repoURL})
+			if err != nil {
+				return err
+			}
+			pull.PullRequestState = state
+		}
+	}
+
+	_, err := b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: pullCardPrefix + issueURL})
+	if err != nil || pull.GetPullRequestState() == pbgh.Issue_NONE {
+		return err
+	}
+	_, err = b.cards.AddCards(ctx, &pb.CardList{Cards: []*pb.Card{b.pullCard(pull)}})
+	return err
+}
+
+// updatePullCards brings the pull request cards in line with github
+func (b *GithubBridge) updatePullCards(ctx context.Context, current []*pb.Card) error {
+	pulls, err := b.getPullRequests()
+	if err != nil {
+		return err
+	}
+
+	cards := []*pb.Card{}
+	for _, pull := range pulls {
+		cards = append(cards, b.pullCard(pull))
+	}
+
+	return b.applyDiff(ctx, diffCards(pullCardPrefix, current, cards, map[string]bool{}))
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestGetPullRequests(t *testing.T) {
+	s := InitTest()
+
+	pulls, err := s.getPullRequests()
+	if err != nil {
+		t.Fatalf("Error getting pull requests: %v", err)
+	}
+
+	if len(pulls) != 3 {
+		t.Fatalf("Wrong number of pull requests: %v", pulls)
+	}
+
+	if pulls[0].PullRequestState != pb.Issue_REVIEW_REQUESTED || pulls[1].PullRequestState != pb.Issue_FAILING || pulls[2].PullRequestState != pb.Issue_AWAITING_MERGE {
+		t.Errorf("Pull requests were categorised incorrectly: %v", pulls)
+	}
+}
+
+func TestPullCardPriority(t *testing.T) {
+	s := InitTest()
+	review := s.pullCard(&pb.Issue{PullRequest: true, PullRequestState: pb.Issue_REVIEW_REQUESTED, Url: "review"})
+	merge := s.pullCard(&pb.Issue{PullRequest: true, PullRequestState: pb.Issue_AWAITING_MERGE, Url: "merge"})
+
+	if review.Priority <= merge.Priority {
+		t.Errorf("Review request should outrank a merge: %v vs %v", review, merge)
+	}
+
+	if review.Hash != "githubpr-review" {
+		t.Errorf("Bad hash: %v", review.Hash)
+	}
+}
+
+func TestUpdatePullCards(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{cards: []*pbc.Card{
+		&pbc.Card{Hash: "githubpr-https://api.github.com/repos/brotherlogic/old/issues/1"},
+		&pbc.Card{Hash: "githubissue-https://api.github.com/repos/brotherlogic/old/issues/2"},
+	}}
+	s.cards = cards
+
+	err := s.updatePullCards(context.Background(), cards.cards)
+	if err != nil {
+		t.Fatalf("Error updating pull cards: %v", err)
+	}
+
+	if len(cards.cards) != 4 || cards.cards[0].Hash != "githubissue-https://api.github.com/repos/brotherlogic/old/issues/2" {
+		t.Errorf("Pull cards were not updated: %v", cards.cards)
+	}
+}
+
+func TestGetPullRequestsSkipsFailures(t *testing.T) {
+	s := InitTest()
+	s.getter = pagedGetter{fail: map[string]bool{"https://api.github.com/repos/brotherlogic/Home/commits/e5bd3914e2e596debea16f433f57875b5b90bcd6/status?access_token=token": true}}
+
+	pulls, err := s.getPullRequests()
+	if err != nil {
+		t.Fatalf("A single failure stopped the pull requests: %v", err)
+	}
+
+	if len(pulls) != 2 || pulls[1].Service != "recordgetter" {
+		t.Errorf("Wrong pull requests: %v", pulls)
+	}
+}
+
+func TestPullStateMalformed(t *testing.T) {
+	s := InitTest()
+
+	for _, item := range []map[string]interface{}{
+		map[string]interface{}{"pull_request": map[string]interface{}{}},
+		map[string]interface{}{"pull_request": map[string]interface{}{"url": "https://api.github.com/repos/brotherlogic/Home/pulls/30"}},
+	} {
+		if _, err := s.pullState(item); err == nil {
+			t.Errorf("Malformed search result was read: %v", item)
+		}
+	}
+}
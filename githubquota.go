@@ -0,0 +1,271 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// QUOTAKEY the filing quotas for services and repos
+	QUOTAKEY = "/github.com/brotherlogic/githubcard/quotas"
+
+	// QUOTASTATEKEY the suppressed issues and last filed issue for each service and repo
+	QUOTASTATEKEY = "/github.com/brotherlogic/githubcard/quota_state"
+)
+
+// bucket is a token bucket for one service or repo
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// suppression counts the issues we've turned away from one service filing into one repo
+type suppression struct {
+	count  int
+	latest string
+}
+
+// validateQuotas checks every quota lets something through
+func validateQuotas(config *pbgh.QuotaConfig) error {
+	for _, q := range append(append([]*pbgh.Quota{}, config.GetServices()...), config.GetRepos()...) {
+		if len(q.GetKey()) == 0 || q.GetPerMinute() <= 0 || q.GetBurst() <= 0 {
+			return fmt.Errorf("Quota for %q needs a positive rate and burst", q.GetKey())
+		}
+	}
+	return nil
+}
+
+// findQuota picks the quota for a key, falling back to the default
+func findQuota(quotas []*pbgh.Quota, key string) *pbgh.Quota {
+	var def *pbgh.Quota
+	for _, q := range quotas {
+		if q.GetKey() == key {
+			return q
+		}
+		if q.GetKey() == "*" {
+			def = q
+		}
+	}
+	return def
+}
+
+// available refills the bucket and reports if it has a token, without taking it
+func (b *GithubBridge) available(name string, quota *pbgh.Quota, now time.Time) bool {
+	if quota == nil {
+		return true
+	}
+
+	bk, ok := b.buckets[name]
+	if !ok {
+		bk = &bucket{tokens: float64(quota.GetBurst()), last: now}
+		b.buckets[name] = bk
+	}
+
+	bk.tokens += now.Sub(bk.last).Minutes() * quota.GetPerMinute()
+	if bk.tokens > float64(quota.GetBurst()) {
+		bk.tokens = float64(quota.GetBurst())
+	}
+	bk.last = now
+	return bk.tokens >= 1
+}
+
+func (b *GithubBridge) take(name string, quota *pbgh.Quota) {
+	if quota != nil {
+		b.buckets[name].tokens--
+	}
+}
+
+// filer is a service filing into a repo
+type filer struct {
+	caller string
+	repo   string
+}
+
+// checkQuota takes a token from both the service and repo buckets, or counts the issue as suppressed
+func (b *GithubBridge) checkQuota(ctx context.Context, caller string, issue *pbgh.Issue, now time.Time) error {
+	b.quotaMutex.Lock()
+	defer b.quotaMutex.Unlock()
+
+	// Callers without a certificate can't be told apart, so sharing a service
+	// bucket would let one of them starve the rest; only the repo quota applies
+	var serviceQuota *pbgh.Quota
+	if caller != unknownCaller {
+		serviceQuota = findQuota(b.quotas.GetServices(), caller)
+	}
+	repoQuota := findQuota(b.quotas.GetRepos(), issue.GetService())
+	if b.available("service/"+caller, serviceQuota, now) && b.available("repo/"+issue.GetService(), repoQuota, now) {
+		b.take("service/"+caller, serviceQuota)
+		b.take("repo/"+issue.GetService(), repoQuota)
+		return nil
+	}
+
+	key := filer{caller: caller, repo: issue.GetService()}
+	s, ok := b.suppressed[key]
+	if !ok {
+		s = &suppression{}
+		b.suppressed[key] = s
+	}
+	s.count++
+	s.latest = issue.GetTitle()
+	b.saveQuotaState(ctx)
+	return status.Errorf(codes.ResourceExhausted, "%v is over quota for %v, the issue will be reported as suppressed", caller, issue.GetService())
+}
+
+// recordFiled remembers the last issue a service filed so suppressions can be reported on it
+func (b *GithubBridge) recordFiled(ctx context.Context, caller string, issue *pbgh.Issue) {
+	b.quotaMutex.Lock()
+	defer b.quotaMutex.Unlock()
+	b.lastFiled[filer{caller: caller, repo: issue.GetService()}] = issue.GetNumber()
+	b.saveQuotaState(ctx)
+}
+
+// suppressedCount is the number of services and repos with issues waiting to be reported
+func (b *GithubBridge) suppressedCount() int {
+	b.quotaMutex.Lock()
+	defer b.quotaMutex.Unlock()
+	return len(b.suppressed)
+}
+
+// pendingReport is a suppression taken out of the map to be reported
+type pendingReport struct {
+	key    filer
+	s      *suppression
+	number int32
+}
+
+// flushSuppressed reports suppressed issues as a single comment on the last issue filed
+func (b *GithubBridge) flushSuppressed(ctx context.Context) {
+	if b.Registry == nil || !b.Registry.Master || b.circuitOpen() {
+		return
+	}
+
+	// Take the reports out under the lock so filing isn't blocked on github
+	b.quotaMutex.Lock()
+	pending := []*pendingReport{}
+	for key, s := range b.suppressed {
+		pending = append(pending, &pendingReport{key: key, s: s, number: b.lastFiled[key]})
+		delete(b.suppressed, key)
+	}
+	b.quotaMutex.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	for _, p := range pending {
+		text := fmt.Sprintf("%v more occurrences from %v suppressed by quota, most recently %q", p.s.count, p.key.caller, p.s.latest)
+
+		var err error
+		if p.number > 0 {
+			url := fmt.Sprintf("https://api.github.com/repos/brotherlogic/%v/issues/%v", p.key.repo, p.number)
+			err = b.runAction(&cardAction{Action: "comment", Issue: url, Text: text})
+			b.audit(ctx, selfCaller, "flush_suppressed", p.key.repo, p.number, err)
+		} else {
+			var rb []byte
+			r := &addResponse{}
+			rb, err = b.AddIssueLocal(ctx, "brotherlogic", p.key.repo, fmt.Sprintf("Suppressed issues from %v", p.key.caller), text)
+			if err == nil {
+				err = json.Unmarshal(rb, r)
+			}
+			b.audit(ctx, selfCaller, "flush_suppressed", p.key.repo, r.Number, err)
+
+			// Later reports go on the issue we just filed
+			if err == nil && r.Number > 0 {
+				b.quotaMutex.Lock()
+				b.lastFiled[p.key] = r.Number
+				b.quotaMutex.Unlock()
+			}
+		}
+
+		// An open report with this title means we've lost its number, retrying won't help
+		if err == errIssueExists {
+			b.Log(fmt.Sprintf("Dropping suppressed issues from %v to %v: %v", p.key.caller, p.key.repo, err))
+		} else if err != nil {
+			b.Log(fmt.Sprintf("Unable to report suppressed issues from %v to %v: %v", p.key.caller, p.key.repo, err))
+			b.requeueSuppressed(p)
+		}
+	}
+
+	b.quotaMutex.Lock()
+	defer b.quotaMutex.Unlock()
+	b.saveQuotaState(ctx)
+}
+
+// requeueSuppressed puts back a report we couldn't send, folding in anything suppressed since
+func (b *GithubBridge) requeueSuppressed(p *pendingReport) {
+	b.quotaMutex.Lock()
+	defer b.quotaMutex.Unlock()
+
+	if s, ok := b.suppressed[p.key]; ok {
+		s.count += p.s.count
+		return
+	}
+	b.suppressed[p.key] = p.s
+}
+
+// saveQuotaState stores the suppressions and last filed issues, callers hold quotaMutex
+func (b *GithubBridge) saveQuotaState(ctx context.Context) {
+	filers := make(map[filer]*pbgh.QuotaFiler)
+	get := func(key filer) *pbgh.QuotaFiler {
+		f, ok := filers[key]
+		if !ok {
+			f = &pbgh.QuotaFiler{Caller: key.caller, Repo: key.repo}
+			filers[key] = f
+		}
+		return f
+	}
+
+	for key, s := range b.suppressed {
+		f := get(key)
+		f.Suppressed = int32(s.count)
+		f.Latest = s.latest
+	}
+	for key, number := range b.lastFiled {
+		get(key).LastFiled = number
+	}
+
+	state := &pbgh.QuotaState{}
+	for _, f := range filers {
+		state.Filers = append(state.Filers, f)
+	}
+	b.KSclient.Save(ctx, QUOTASTATEKEY, state)
+}
+
+func (b *GithubBridge) readQuotaState(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, QUOTASTATEKEY, &pbgh.QuotaState{})
+	if err != nil {
+		return err
+	}
+
+	b.quotaMutex.Lock()
+	defer b.quotaMutex.Unlock()
+	for _, f := range data.(*pbgh.QuotaState).GetFilers() {
+		key := filer{caller: f.GetCaller(), repo: f.GetRepo()}
+		if f.GetSuppressed() > 0 {
+			b.suppressed[key] = &suppression{count: int(f.GetSuppressed()), latest: f.GetLatest()}
+		}
+		if f.GetLastFiled() > 0 {
+			b.lastFiled[key] = f.GetLastFiled()
+		}
+	}
+	return nil
+}
+
+func (b *GithubBridge) readQuotas(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, QUOTAKEY, &pbgh.QuotaConfig{})
+	if err != nil {
+		return err
+	}
+
+	b.quotaMutex.Lock()
+	defer b.quotaMutex.Unlock()
+	b.quotas = data.(*pbgh.QuotaConfig)
+	return nil
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestValidateQuotas(t *testing.T) {
+	if validateQuotas(&pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "*", PerMinute: 1, Burst: 5}}}) != nil {
+		t.Errorf("Good quota was rejected")
+	}
+	if validateQuotas(&pb.QuotaConfig{Repos: []*pb.Quota{&pb.Quota{Key: "Home", Burst: 5}}}) == nil {
+		t.Errorf("Quota with no rate was accepted")
+	}
+}
+
+func TestQuotaRefills(t *testing.T) {
+	s := InitTest()
+	s.quotas = &pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "*", PerMinute: 1, Burst: 2}}}
+	issue := &pb.Issue{Title: "Crashing", Service: "Home"}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if err := s.checkQuota(context.Background(), "crasher", issue, now); err != nil {
+			t.Fatalf("Issue %v was limited: %v", i, err)
+		}
+	}
+	if err := s.checkQuota(context.Background(), "crasher", issue, now); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Issue over burst was let through: %v", err)
+	}
+	if err := s.checkQuota(context.Background(), "recordgetter", issue, now); err != nil {
+		t.Errorf("Other service was limited: %v", err)
+	}
+	if err := s.checkQuota(context.Background(), "crasher", issue, now.Add(time.Minute)); err != nil {
+		t.Errorf("Bucket did not refill: %v", err)
+	}
+
+	if s.suppressed[filer{caller: "crasher", repo: "Home"}].count != 1 {
+		t.Errorf("Suppression was not counted: %v", s.suppressed)
+	}
+}
+
+func TestRepoQuota(t *testing.T) {
+	s := InitTest()
+	s.quotas = &pb.QuotaConfig{Repos: []*pb.Quota{&pb.Quota{Key: "Home", PerMinute: 1, Burst: 1}}}
+	now := time.Now()
+
+	if err := s.checkQuota(context.Background(), "crasher", &pb.Issue{Title: "Crashing", Service: "Home"}, now); err != nil {
+		t.Fatalf("First issue was limited: %v", err)
+	}
+	if err := s.checkQuota(context.Background(), "recordgetter", &pb.Issue{Title: "Crashing", Service: "Home"}, now); err == nil {
+		t.Errorf("Repo quota was not shared between services")
+	}
+	if err := s.checkQuota(context.Background(), "recordgetter", &pb.Issue{Title: "Crashing", Service: "githubcard"}, now); err != nil {
+		t.Errorf("Unlimited repo was limited: %v", err)
+	}
+}
+
+func TestAddIssueOverQuota(t *testing.T) {
+	s := InitTest()
+	s.quotas = &pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "*", PerMinute: 1, Burst: 1}}}
+
+	_, err := s.AddIssue(callerContext("crasher"), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+	if err != nil {
+		t.Fatalf("First issue failed: %v", err)
+	}
+	_, err = s.AddIssue(callerContext("crasher"), &pb.Issue{Title: "Testing again", Body: "This is a test issue", Service: "Home"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Second issue was not limited: %v", err)
+	}
+}
+
+func TestUnidentifiedCallersOnlyRepoLimited(t *testing.T) {
+	s := InitTest()
+	s.quotas = &pb.QuotaConfig{
+		Services: []*pb.Quota{&pb.Quota{Key: "*", PerMinute: 1, Burst: 1}},
+		Repos:    []*pb.Quota{&pb.Quota{Key: "Home", PerMinute: 1, Burst: 2}},
+	}
+	now := time.Now()
+
+	if err := s.checkQuota(context.Background(), unknownCaller, &pb.Issue{Title: "Crashing", Service: "crasher"}, now); err != nil {
+		t.Fatalf("First issue was limited: %v", err)
+	}
+	if err := s.checkQuota(context.Background(), unknownCaller, &pb.Issue{Title: "Crashing", Service: "Home"}, now); err != nil {
+		t.Errorf("Unidentified callers shared a service bucket: %v", err)
+	}
+	if err := s.checkQuota(context.Background(), unknownCaller, &pb.Issue{Title: "Crashing", Service: "Home"}, now); err != nil {
+		t.Errorf("Second issue to the repo was limited: %v", err)
+	}
+	if err := s.checkQuota(context.Background(), unknownCaller, &pb.Issue{Title: "Crashing", Service: "Home"}, now); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Repo quota was not applied: %v", err)
+	}
+}
+
+func TestFlushSuppressedRecordsNewIssue(t *testing.T) {
+	s := InitTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+	key := filer{caller: "crasher", repo: "Home"}
+	s.suppressed[key] = &suppression{count: 12, latest: "Crashing"}
+
+	s.flushSuppressed(context.Background())
+	if s.lastFiled[key] != 494 {
+		t.Fatalf("Report issue was not recorded: %v", s.lastFiled)
+	}
+
+	s.suppressed[key] = &suppression{count: 3, latest: "Crashing"}
+	s.flushSuppressed(context.Background())
+	if len(sent) != 2 || strings.Contains(sent[1], "Suppressed issues from") || !strings.Contains(sent[1], "3 more occurrences") {
+		t.Errorf("Second report was not a comment: %v", sent)
+	}
+}
+
+func TestFlushSuppressed(t *testing.T) {
+	s := InitTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+	s.suppressed[filer{caller: "crasher", repo: "Home"}] = &suppression{count: 12, latest: "Crashing"}
+	s.lastFiled[filer{caller: "crasher", repo: "Home"}] = 12
+
+	s.flushSuppressed(context.Background())
+
+	if len(s.suppressed) != 0 {
+		t.Errorf("Suppressions were not flushed: %v", s.suppressed)
+	}
+	if len(sent) != 1 || !strings.Contains(sent[0], "12 more occurrences") {
+		t.Errorf("Bad suppression report: %v", sent)
+	}
+}
+
+// lockCheckGetter fails the test if check blocks on a lock while we talk to github
+type lockCheckGetter struct {
+	testFileGetter
+	check func()
+	t     *testing.T
+	sent  *int
+}
+
+func (httpGetter lockCheckGetter) Post(url string, data string) (*http.Response, error) {
+	done := make(chan bool)
+	go func() {
+		httpGetter.check()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		httpGetter.t.Fatalf("Lock was held while posting")
+	}
+	*httpGetter.sent++
+	return httpGetter.testFileGetter.Post(url, data)
+}
+
+func TestFlushSuppressedReleasesLock(t *testing.T) {
+	s := InitTest()
+	sent := 0
+	s.getter = lockCheckGetter{check: func() { s.suppressedCount() }, t: t, sent: &sent}
+	s.suppressed[filer{caller: "crasher", repo: "Home"}] = &suppression{count: 12, latest: "Crashing"}
+	s.lastFiled[filer{caller: "crasher", repo: "Home"}] = 12
+
+	s.flushSuppressed(context.Background())
+
+	if sent != 1 || s.suppressedCount() != 0 {
+		t.Errorf("Suppressions were not flushed: %v, %v", sent, s.suppressed)
+	}
+}
+
+func TestFlushSuppressedWaitsForBreaker(t *testing.T) {
+	s := InitTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+	s.circuit = &breaker{state: breakerOpen, opened: time.Now()}
+	s.suppressed[filer{caller: "crasher", repo: "Home"}] = &suppression{count: 12, latest: "Crashing"}
+
+	s.flushSuppressed(context.Background())
+
+	if len(sent) != 0 || s.suppressedCount() != 1 {
+		t.Errorf("Suppressions were flushed while github was down: %v, %v", sent, s.suppressed)
+	}
+}
+
+func TestFailedFlushKeepsSuppressions(t *testing.T) {
+	s := InitTest()
+	s.getter = downGetter{}
+	s.suppressed[filer{caller: "crasher", repo: "Home"}] = &suppression{count: 12, latest: "Crashing"}
+	s.lastFiled[filer{caller: "crasher", repo: "Home"}] = 12
+
+	s.flushSuppressed(context.Background())
+
+	if s.suppressed[filer{caller: "crasher", repo: "Home"}].count != 12 {
+		t.Errorf("Failed report was dropped: %v", s.suppressed)
+	}
+}
+
+func TestQuotaStateSurvivesRestart(t *testing.T) {
+	s := InitTest()
+	s.quotas = &pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "*", PerMinute: 1, Burst: 1}}}
+
+	issue, err := s.AddIssue(callerContext("crasher"), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+	if err != nil {
+		t.Fatalf("First issue failed: %v", err)
+	}
+	_, err = s.AddIssue(callerContext("crasher"), &pb.Issue{Title: "Testing again", Body: "This is a test issue", Service: "Home"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Second issue was not limited: %v", err)
+	}
+
+	s.suppressed = make(map[filer]*suppression)
+	s.lastFiled = make(map[filer]int32)
+	err = s.readQuotaState(context.Background())
+	if err != nil {
+		t.Fatalf("Unable to read quota state: %v", err)
+	}
+
+	key := filer{caller: "crasher", repo: "Home"}
+	if s.suppressed[key] == nil || s.suppressed[key].count != 1 || s.suppressed[key].latest != "Testing again" || s.lastFiled[key] != issue.GetNumber() {
+		t.Errorf("Quota state was not restored: %v, %v", s.suppressed, s.lastFiled)
+	}
+}
+
+func TestQuotaKeyedOnIdentity(t *testing.T) {
+	s := InitTest()
+	s.quotas = &pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "*", PerMinute: 1, Burst: 1}}}
+
+	_, err := s.AddIssue(callerContext("crasher"), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home"})
+	if err != nil {
+		t.Fatalf("First issue failed: %v", err)
+	}
+	_, err = s.AddIssue(callerContext("recordgetter"), &pb.Issue{Title: "Testing again", Body: "This is a test issue", Service: "Home"})
+	if err != nil {
+		t.Errorf("Other service shared the bucket: %v", err)
+	}
+}
+
+func TestSetQuotas(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.SetQuotas(context.Background(), &pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "crasher"}}})
+	if err == nil {
+		t.Errorf("Bad quota was saved")
+	}
+
+	_, err = s.SetQuotas(context.Background(), &pb.QuotaConfig{Services: []*pb.Quota{&pb.Quota{Key: "crasher", PerMinute: 1, Burst: 5}}})
+	if err != nil {
+		t.Fatalf("Unable to set quotas: %v", err)
+	}
+
+	quotas, err := s.GetQuotas(context.Background(), &pb.Empty{})
+	if err != nil || len(quotas.GetServices()) != 1 {
+		t.Errorf("Bad quotas: %v, %v", quotas, err)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+// detector finds one kind of secret or personal data
+type detector struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// The built in detectors, run in order
+var detectors = []detector{
+	{"github_token", regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{"aws_key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"bearer", regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`)},
+	{"email", regexp.MustCompile(`\b[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}\b`)},
+	{"ip", regexp.MustCompile(`\b(?:[0-9]{1,3}\.){3}[0-9]{1,3}\b`)},
+}
+
+// validateRedactions checks that custom patterns compile
+func validateRedactions(patterns []string) error {
+	for _, r := range patterns {
+		if _, err := regexp.Compile(r); err != nil {
+			return fmt.Errorf("Bad redaction %v: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// redact blanks out everything the detectors and custom patterns find, counting what went
+func redact(text string, custom []string, counts map[string]int) string {
+	run := func(name string, pattern *regexp.Regexp) {
+		text = pattern.ReplaceAllStringFunc(text, func(string) string {
+			counts[name]++
+			return "[REDACTED " + name + "]"
+		})
+	}
+
+	for _, d := range detectors {
+		run(d.name, d.pattern)
+	}
+	for _, c := range custom {
+		if pattern, err := regexp.Compile(c); err == nil {
+			run("custom", pattern)
+		}
+	}
+	return text
+}
+
+// redactFor redacts text bound for the given repo, using that repo's route
+func (b *GithubBridge) redactFor(repo, text string) string {
+	custom := []string{}
+	if route := b.routeFor(&pbgh.Issue{Service: repo}); route != nil {
+		custom = route.GetRedactions()
+	}
+
+	b.redactMutex.Lock()
+	defer b.redactMutex.Unlock()
+	return redact(text, custom, b.redactions)
+}
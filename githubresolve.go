@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// RESOLVEKEY the fingerprinted issues services can resolve
+	RESOLVEKEY = "/github.com/brotherlogic/githubcard/tracked"
+
+	// How long a service must stay healthy if it doesn't say
+	defaultQuietPeriod = time.Hour
+)
+
+// trackedIssue finds the open issue filed in a repo under a fingerprint
+func (b *GithubBridge) trackedIssue(service, fingerprint string) *pbgh.TrackedIssue {
+	for _, tracked := range b.tracked.GetIssues() {
+		if tracked.GetService() == service && tracked.GetFingerprint() == fingerprint {
+			return tracked
+		}
+	}
+	return nil
+}
+
+func trackedURL(tracked *pbgh.TrackedIssue) string {
+	return fmt.Sprintf("https://api.github.com/repos/brotherlogic/%v/issues/%v", tracked.GetService(), tracked.GetNumber())
+}
+
+// trackedOpen checks the mirror, then github, for whether a tracked issue is
+// still open, assuming it is if we can't tell
+func (b *GithubBridge) trackedOpen(tracked *pbgh.TrackedIssue) bool {
+	b.mirrorMutex.Lock()
+	for _, issue := range b.mirror.GetIssues() {
+		if issue.GetUrl() == trackedURL(tracked) {
+			b.mirrorMutex.Unlock()
+			return true
+		}
+	}
+	b.mirrorMutex.Unlock()
+
+	issue, err := b.GetIssueLocal("brotherlogic", tracked.GetService(), int(tracked.GetNumber()))
+	if err != nil {
+		b.Log(fmt.Sprintf("Unable to check %v: %v", trackedURL(tracked), err))
+		return true
+	}
+	return issue.GetState() == pbgh.Issue_OPEN
+}
+
+// untrack forgets about a tracked issue
+func (b *GithubBridge) untrack(ctx context.Context, tracked *pbgh.TrackedIssue) {
+	b.trackedMutex.Lock()
+	defer b.trackedMutex.Unlock()
+
+	remaining := []*pbgh.TrackedIssue{}
+	for _, t := range b.tracked.GetIssues() {
+		if t != tracked {
+			remaining = append(remaining, t)
+		}
+	}
+	b.tracked.Issues = remaining
+	b.KSclient.Save(ctx, RESOLVEKEY, b.tracked)
+}
+
+// trackIssue remembers the issue filed for a fingerprint
+func (b *GithubBridge) trackIssue(ctx context.Context, issue *pbgh.Issue) {
+	b.trackedMutex.Lock()
+	defer b.trackedMutex.Unlock()
+	b.tracked.Issues = append(b.tracked.Issues, &pbgh.TrackedIssue{Fingerprint: issue.GetFingerprint(), Service: issue.GetService(), Number: issue.GetNumber()})
+	b.KSclient.Save(ctx, RESOLVEKEY, b.tracked)
+}
+
+// recurred handles a problem being reported again, returning the issue already
+// filed for it and cancelling any pending resolution
+func (b *GithubBridge) recurred(ctx context.Context, issue *pbgh.Issue) *pbgh.TrackedIssue {
+	b.trackedMutex.Lock()
+	tracked := b.trackedIssue(issue.GetService(), issue.GetFingerprint())
+	b.trackedMutex.Unlock()
+	if tracked == nil {
+		return nil
+	}
+
+	//Someone closed it by hand, so this is a new occurrence
+	if !b.trackedOpen(tracked) {
+		b.untrack(ctx, tracked)
+		return nil
+	}
+
+	b.trackedMutex.Lock()
+	defer b.trackedMutex.Unlock()
+	if tracked.GetResolvedAt() > 0 {
+		tracked.ResolvedAt = 0
+		b.KSclient.Save(ctx, RESOLVEKEY, b.tracked)
+	}
+	return tracked
+}
+
+// resolve starts the quiet period for a fingerprinted issue
+func (b *GithubBridge) resolve(ctx context.Context, in *pbgh.ResolveRequest) (*pbgh.TrackedIssue, error) {
+	b.trackedMutex.Lock()
+	defer b.trackedMutex.Unlock()
+
+	tracked := b.trackedIssue(in.GetService(), in.GetFingerprint())
+	if tracked == nil {
+		return nil, fmt.Errorf("No open issue for %v in %v", in.GetFingerprint(), in.GetService())
+	}
+
+	// Heartbeats after the first don't restart the clock
+	if tracked.GetResolvedAt() == 0 {
+		tracked.ResolvedAt = time.Now().Unix()
+		tracked.QuietPeriod = in.GetQuietPeriod()
+		if tracked.QuietPeriod <= 0 {
+			tracked.QuietPeriod = int64(defaultQuietPeriod.Seconds())
+		}
+		err := b.KSclient.Save(ctx, RESOLVEKEY, b.tracked)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tracked, nil
+}
+
+// closeResolved closes every issue whose service has stayed healthy through the quiet period
+func (b *GithubBridge) closeResolved(ctx context.Context) {
+	if b.Registry == nil || !b.Registry.Master {
+		return
+	}
+
+	// Pick out what's due under the lock so filing isn't blocked on github
+	type due struct {
+		tracked *pbgh.TrackedIssue
+		service string
+		url     string
+	}
+	b.trackedMutex.Lock()
+	pending := []*due{}
+	for _, tracked := range b.tracked.GetIssues() {
+		if tracked.GetResolvedAt() > 0 && time.Now().Unix()-tracked.GetResolvedAt() >= tracked.GetQuietPeriod() {
+			pending = append(pending, &due{tracked: tracked, service: tracked.GetService(), url: trackedURL(tracked)})
+		}
+	}
+	b.trackedMutex.Unlock()
+
+	closed := make(map[*pbgh.TrackedIssue]bool)
+	for _, d := range pending {
+		err := b.runAction(&cardAction{Action: "comment", Issue: d.url,
+			Text: fmt.Sprintf("%v has reported it is healthy again, closing.", d.service)})
+		if err == nil {
+			err = b.runAction(&cardAction{Action: "close", Issue: d.url})
+		}
+		b.auditURL(ctx, selfCaller, "close_resolved", d.url, err)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to close %v: %v", d.url, err))
+			continue
+		}
+		b.mirrorIssue(&pbgh.Issue{Url: d.url, State: pbgh.Issue_CLOSED})
+		closed[d.tracked] = true
+	}
+
+	if len(closed) == 0 {
+		return
+	}
+
+	b.trackedMutex.Lock()
+	defer b.trackedMutex.Unlock()
+	remaining := []*pbgh.TrackedIssue{}
+	for _, tracked := range b.tracked.GetIssues() {
+		if !closed[tracked] {
+			remaining = append(remaining, tracked)
+		}
+	}
+	b.tracked.Issues = remaining
+	b.KSclient.Save(ctx, RESOLVEKEY, b.tracked)
+	b.saveMirror(ctx)
+}
+
+func (b *GithubBridge) readTracked(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, RESOLVEKEY, &pbgh.TrackedIssues{})
+	if err != nil {
+		return err
+	}
+
+	b.trackedMutex.Lock()
+	defer b.trackedMutex.Unlock()
+	b.tracked = data.(*pbgh.TrackedIssues)
+	return nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestAddFingerprintedIssue(t *testing.T) {
+	s := InitTest()
+
+	issue, err := s.AddIssue(context.Background(), &pb.Issue{Title: "Testing", Body: "This is a test issue", Service: "Home", Fingerprint: "home-down"})
+	if err != nil {
+		t.Fatalf("Error adding issue: %v", err)
+	}
+
+	// The same problem under a new title doesn't get filed again
+	again, err := s.AddIssue(context.Background(), &pb.Issue{Title: "Testing again", Service: "Home", Fingerprint: "home-down"})
+	if err != nil {
+		t.Fatalf("Error re-adding issue: %v", err)
+	}
+
+	if issue.Number != 494 || again.Number != 494 || len(s.tracked.GetIssues()) != 1 {
+		t.Errorf("Issue was not tracked: %v", s.tracked)
+	}
+}
+
+func TestResolveUnknownIssue(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.ResolveIssue(context.Background(), &pb.ResolveRequest{Fingerprint: "madeup"})
+	if err == nil {
+		t.Errorf("Unknown fingerprint was resolved")
+	}
+}
+
+func TestResolveThenRecur(t *testing.T) {
+	s := InitTest()
+	s.tracked = &pb.TrackedIssues{Issues: []*pb.TrackedIssue{&pb.TrackedIssue{Fingerprint: "home-down", Service: "Home", Number: 12}}}
+
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{&pb.Issue{Url: "https://api.github.com/repos/brotherlogic/Home/issues/12", State: pb.Issue_OPEN}}}
+
+	resp, err := s.ResolveIssue(context.Background(), &pb.ResolveRequest{Fingerprint: "home-down", Service: "Home"})
+	if err != nil {
+		t.Fatalf("Error resolving: %v", err)
+	}
+	if resp.GetIssue().GetResolvedAt() == 0 || resp.GetIssue().GetQuietPeriod() != 3600 {
+		t.Errorf("Resolution was not recorded: %v", resp)
+	}
+
+	_, err = s.AddIssue(context.Background(), &pb.Issue{Title: "Home is down", Service: "Home", Fingerprint: "home-down"})
+	if err != nil {
+		t.Fatalf("Error re-adding issue: %v", err)
+	}
+	if s.tracked.GetIssues()[0].GetResolvedAt() != 0 {
+		t.Errorf("Recurrence did not cancel the resolution: %v", s.tracked)
+	}
+}
+
+func TestCloseResolved(t *testing.T) {
+	s := InitTest()
+	s.tracked = &pb.TrackedIssues{Issues: []*pb.TrackedIssue{
+		&pb.TrackedIssue{Fingerprint: "home-down", Service: "Home", Number: 12, ResolvedAt: time.Now().Add(-time.Hour * 2).Unix(), QuietPeriod: 3600},
+		&pb.TrackedIssue{Fingerprint: "home-slow", Service: "Home", Number: 13, ResolvedAt: time.Now().Unix(), QuietPeriod: 3600},
+	}}
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{&pb.Issue{Url: "https://api.github.com/repos/brotherlogic/Home/issues/12"}}}
+
+	s.closeResolved(context.Background())
+
+	if len(s.tracked.GetIssues()) != 1 || s.tracked.GetIssues()[0].GetFingerprint() != "home-slow" {
+		t.Errorf("Wrong issues closed: %v", s.tracked)
+	}
+	if len(s.mirror.GetIssues()) != 0 {
+		t.Errorf("Closed issue is still mirrored: %v", s.mirror)
+	}
+}
+
+func TestCloseResolvedReleasesLock(t *testing.T) {
+	s := InitTest()
+	sent := 0
+	s.getter = lockCheckGetter{check: func() { s.trackedMutex.Lock(); s.trackedMutex.Unlock() }, t: t, sent: &sent}
+	s.tracked = &pb.TrackedIssues{Issues: []*pb.TrackedIssue{
+		&pb.TrackedIssue{Fingerprint: "home-down", Service: "Home", Number: 12, ResolvedAt: time.Now().Add(-time.Hour * 2).Unix(), QuietPeriod: 3600},
+	}}
+
+	s.closeResolved(context.Background())
+
+	if sent != 1 || len(s.tracked.GetIssues()) != 0 {
+		t.Errorf("Issue was not closed: %v, %v", sent, s.tracked)
+	}
+}
+
+func TestRecurAfterManualClose(t *testing.T) {
+	s := InitTest()
+	s.tracked = &pb.TrackedIssues{Issues: []*pb.TrackedIssue{&pb.TrackedIssue{Fingerprint: "home-down", Service: "Home", Number: 12}}}
+
+	issue, err := s.AddIssue(context.Background(), &pb.Issue{Title: "Home is down", Service: "Home", Fingerprint: "home-down"})
+	if err != nil {
+		t.Fatalf("Error re-adding issue: %v", err)
+	}
+
+	if issue.Number != 494 || len(s.tracked.GetIssues()) != 1 || s.tracked.GetIssues()[0].GetNumber() != 494 {
+		t.Errorf("Closed issue was not replaced: %v -> %v", issue, s.tracked)
+	}
+}
+
+func TestFingerprintsPerService(t *testing.T) {
+	s := InitTest()
+	s.tracked = &pb.TrackedIssues{Issues: []*pb.TrackedIssue{&pb.TrackedIssue{Fingerprint: "disk-full", Service: "crasher", Number: 15}}}
+
+	issue, err := s.AddIssue(context.Background(), &pb.Issue{Title: "Disk is full", Service: "Home", Fingerprint: "disk-full"})
+	if err != nil {
+		t.Fatalf("Error adding issue: %v", err)
+	}
+
+	if issue.Number != 494 || len(s.tracked.GetIssues()) != 2 {
+		t.Errorf("Fingerprint from another service was reused: %v -> %v", issue, s.tracked)
+	}
+
+	_, err = s.ResolveIssue(context.Background(), &pb.ResolveRequest{Fingerprint: "disk-full", Service: "crasher"})
+	if err != nil || s.tracked.GetIssues()[0].GetResolvedAt() == 0 || s.tracked.GetIssues()[1].GetResolvedAt() != 0 {
+		t.Errorf("Wrong issue resolved: %v, %v", s.tracked, err)
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+var (
+	mention  = regexp.MustCompile("(^|[^A-Za-z0-9_`])(@[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?(?:/[A-Za-z0-9_.-]+)?)")
+	issueRef = regexp.MustCompile("(^|[^A-Za-z0-9_&`])((?:[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+)?#[0-9]+)\\b")
+
+	// Lines that look like they came out of a log or a stack trace
+	logLine = regexp.MustCompile(`^([0-9]{4}[/-][0-9]{2}[/-][0-9]{2}[ T][0-9]{2}:[0-9]{2}|goroutine [0-9]+ \[|panic: |Traceback \(most recent call last\)|\s+File ".*", line [0-9]+|\s+at \S+\(|\s+\S+\.go:[0-9]+|\t)`)
+)
+
+// neutralise stops mentions and issue references from pinging or linking
+func neutralise(text string) string {
+	text = mention.ReplaceAllString(text, "$1`$2`")
+	return issueRef.ReplaceAllString(text, "$1`$2`")
+}
+
+// sanitiseText fences runs of log lines and neutralises everything else,
+// anything already fenced is left as it is
+func sanitiseText(text string) string {
+	out := []string{}
+	fenced := false
+	inLog := false
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, "```") {
+			fenced = !fenced
+		}
+
+		isLog := !fenced && !strings.HasPrefix(line, "```") && logLine.MatchString(line)
+		if isLog && !inLog {
+			out = append(out, "```")
+		}
+		if !isLog && inLog {
+			out = append(out, "```")
+		}
+		inLog = isLog
+
+		if fenced || isLog || strings.HasPrefix(line, "```") {
+			out = append(out, line)
+		} else {
+			out = append(out, neutralise(line))
+		}
+	}
+	if inLog {
+		out = append(out, "```")
+	}
+	return strings.Join(out, "\n")
+}
+
+// sanitiseIssue cleans up the fields a service sent us, stack traces are left
+// for the template to place
+func sanitiseIssue(issue *pbgh.Issue) {
+	issue.Title = neutralise(issue.GetTitle())
+	issue.Body = sanitiseText(issue.GetBody())
+	issue.Error = neutralise(issue.GetError())
+
+	metadata := make(map[string]string)
+	for k, v := range issue.GetMetadata() {
+		metadata[k] = neutralise(v)
+	}
+	issue.Metadata = metadata
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestNeutralise(t *testing.T) {
+	text := neutralise("cc @simon and @brotherlogic/team about #12 and brotherlogic/Home#494, mail simon@example.com or see &#39; and `@already`")
+	expected := "cc `@simon` and `@brotherlogic/team` about `#12` and `brotherlogic/Home#494`, mail simon@example.com or see &#39; and `@already`"
+
+	if text != expected {
+		t.Errorf("Bad neutralise:\n%v\n%v", text, expected)
+	}
+}
+
+func TestSanitiseTextFencesLogs(t *testing.T) {
+	text := sanitiseText("Crashed for @simon\n2017/09/26 17:48:18 starting\npanic: Whoopsie\ngoroutine 41 [running]:\n\t/home/simon/Crasher.go:36 +0x6c\nSee #12\n```\n@kept\n```")
+	expected := "Crashed for `@simon`\n```\n2017/09/26 17:48:18 starting\npanic: Whoopsie\ngoroutine 41 [running]:\n\t/home/simon/Crasher.go:36 +0x6c\n```\nSee `#12`\n```\n@kept\n```"
+
+	if text != expected {
+		t.Errorf("Bad sanitise:\n%v\n%v", text, expected)
+	}
+}
+
+func TestSanitiseKeepsTemplateFormatting(t *testing.T) {
+	s := InitTest()
+	s.filing = &pb.FilingConfig{
+		Templates: []*pb.Template{&pb.Template{Name: "crash", Title: "{{.Title}}", Body: "## Error\n{{.Error}}\n\n```\n{{.Stack}}\n```\n@brotherlogic"}},
+		Routes:    []*pb.Route{&pb.Route{Service: "crasher", Template: "crash", Sanitise: true}},
+	}
+
+	issue := &pb.Issue{Service: "crasher", Title: "Broke #3", Error: "ask @simon", Stack: "main.go:12"}
+	err := s.renderIssue(issue)
+	if err != nil {
+		t.Fatalf("Error rendering: %v", err)
+	}
+
+	if issue.Title != "Broke `#3`" || issue.Body != "## Error\nask `@simon`\n\n```\nmain.go:12\n```\n@brotherlogic" {
+		t.Errorf("Bad render: %v / %v", issue.Title, issue.Body)
+	}
+}
+
+func TestSanitiseWithoutTemplate(t *testing.T) {
+	s := InitTest()
+	s.filing = &pb.FilingConfig{Routes: []*pb.Route{&pb.Route{Sanitise: true}}}
+
+	issue, err := s.AddIssue(context.Background(), &pb.Issue{Service: "Home", Title: "Testing", Body: "Ping @simon"})
+	if err != nil {
+		t.Fatalf("Error adding issue: %v", err)
+	}
+	if issue.Body != "Ping `@simon`" {
+		t.Errorf("Body was not sanitised: %v", issue.Body)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// SCORINGKEY the card priority rules
+	SCORINGKEY = "/github.com/brotherlogic/githubcard/scoring"
+)
+
+// defaultScoring ranks by age, with priority labels trumping everything else;
+// pull requests waiting on us come ahead of those waiting on others
+func defaultScoring() *pbgh.ScoringConfig {
+	return &pbgh.ScoringConfig{
+		Rules: []*pbgh.ScoringRule{
+			&pbgh.ScoringRule{Factor: pbgh.ScoringRule_AGE, Weight: 1},
+			&pbgh.ScoringRule{Factor: pbgh.ScoringRule_LABEL, Match: "P0", Weight: 10000},
+			&pbgh.ScoringRule{Factor: pbgh.ScoringRule_LABEL, Match: "P1", Weight: 1000},
+			&pbgh.ScoringRule{Factor: pbgh.ScoringRule_AGE, Weight: 1, PullRequests: true},
+			&pbgh.ScoringRule{Factor: pbgh.ScoringRule_PULL_REQUEST_STATE, Match: "REVIEW_REQUESTED", Weight: 5000, PullRequests: true},
+			&pbgh.ScoringRule{Factor: pbgh.ScoringRule_PULL_REQUEST_STATE, Match: "FAILING", Weight: 2000, PullRequests: true},
+			&pbgh.ScoringRule{Factor: pbgh.ScoringRule_PULL_REQUEST_STATE, Match: "AWAITING_MERGE", Weight: 1000, PullRequests: true},
+		},
+	}
+}
+
+// scoreRule computes a single rule's contribution to the score
+func scoreRule(rule *pbgh.ScoringRule, issue *pbgh.Issue, now time.Time) int32 {
+	switch rule.GetFactor() {
+	case pbgh.ScoringRule_AGE:
+		return rule.GetWeight() * int32(now.Sub(time.Unix(issue.GetCreatedAt(), 0)).Hours()/24)
+	case pbgh.ScoringRule_LABEL:
+		for _, label := range issue.GetLabels() {
+			if label == rule.GetMatch() {
+				return rule.GetWeight()
+			}
+		}
+	case pbgh.ScoringRule_COMMENTS:
+		return rule.GetWeight() * issue.GetComments()
+	case pbgh.ScoringRule_REACTIONS:
+		return rule.GetWeight() * issue.GetReactions()
+	case pbgh.ScoringRule_ASSIGNEE:
+		if len(issue.GetAssignee()) > 0 && (len(rule.GetMatch()) == 0 || issue.GetAssignee() == rule.GetMatch()) {
+			return rule.GetWeight()
+		}
+	case pbgh.ScoringRule_REPO:
+		if issue.GetService() == rule.GetMatch() {
+			return rule.GetWeight()
+		}
+	case pbgh.ScoringRule_PULL_REQUEST_STATE:
+		if issue.GetPullRequestState().String() == rule.GetMatch() {
+			return rule.GetWeight()
+		}
+	}
+	return 0
+}
+
+// scoreIssue works out the card priority for an issue
+func (b *GithubBridge) scoreIssue(issue *pbgh.Issue, now time.Time) *pbgh.ScoreBreakdown {
+	breakdown := &pbgh.ScoreBreakdown{}
+	for _, rule := range b.scoring.GetRules() {
+		if rule.GetPullRequests() != issue.GetPullRequest() {
+			continue
+		}
+		score := scoreRule(rule, issue, now)
+		if score != 0 {
+			breakdown.Total += score
+			breakdown.Components = append(breakdown.Components, &pbgh.ScoreComponent{Rule: fmt.Sprintf("%v:%v", rule.GetFactor(), rule.GetMatch()), Score: score})
+		}
+	}
+	return breakdown
+}
+
+func (b *GithubBridge) readScoring(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, SCORINGKEY, &pbgh.ScoringConfig{})
+	if err != nil {
+		return err
+	}
+	b.scoring = data.(*pbgh.ScoringConfig)
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestCrashOutranksOldIssue(t *testing.T) {
+	s := InitTest()
+	now := time.Now()
+	old := &pb.Issue{Title: "Trivia", CreatedAt: now.AddDate(-1, 0, 0).Unix()}
+	crash := &pb.Issue{Title: "Production crash", CreatedAt: now.Unix(), Labels: []string{"P0"}}
+
+	if s.scoreIssue(old, now).Total >= s.scoreIssue(crash, now).Total {
+		t.Errorf("Old issue outranks the crash: %v vs %v", s.scoreIssue(old, now), s.scoreIssue(crash, now))
+	}
+}
+
+func TestScoreBreakdown(t *testing.T) {
+	s := InitTest()
+	s.scoring = &pb.ScoringConfig{Rules: []*pb.ScoringRule{
+		&pb.ScoringRule{Factor: pb.ScoringRule_COMMENTS, Weight: 2},
+		&pb.ScoringRule{Factor: pb.ScoringRule_REACTIONS, Weight: 3},
+		&pb.ScoringRule{Factor: pb.ScoringRule_ASSIGNEE, Match: "brotherlogic", Weight: 5},
+		&pb.ScoringRule{Factor: pb.ScoringRule_REPO, Match: "crasher", Weight: 7},
+		&pb.ScoringRule{Factor: pb.ScoringRule_REPO, Match: "Home", Weight: 100},
+	}}
+
+	breakdown := s.scoreIssue(&pb.Issue{Service: "crasher", Comments: 2, Reactions: 1, Assignee: "brotherlogic"}, time.Now())
+
+	if breakdown.Total != 19 || len(breakdown.Components) != 4 {
+		t.Errorf("Bad breakdown: %v", breakdown)
+	}
+}
+
+func TestSetScoring(t *testing.T) {
+	s := InitTest()
+	config := &pb.ScoringConfig{Rules: []*pb.ScoringRule{&pb.ScoringRule{Factor: pb.ScoringRule_COMMENTS, Weight: 2}}}
+
+	_, err := s.SetScoring(context.Background(), config)
+	if err != nil {
+		t.Fatalf("Error setting scoring: %v", err)
+	}
+
+	s.scoring = defaultScoring()
+	err = s.readScoring(context.Background())
+	if err != nil {
+		t.Fatalf("Error reading scoring: %v", err)
+	}
+
+	read, err := s.GetScoring(context.Background(), &pb.Empty{})
+	if err != nil || len(read.Rules) != 1 || read.Rules[0].Factor != pb.ScoringRule_COMMENTS {
+		t.Errorf("Scoring was not stored: %v, %v", read, err)
+	}
+}
+
+func TestExplainScore(t *testing.T) {
+	s := InitTest()
+
+	breakdown, err := s.ExplainScore(context.Background(), &pb.Issue{Service: "Home", Number: 12})
+	if err != nil {
+		t.Fatalf("Error explaining score: %v", err)
+	}
+
+	if breakdown.Total <= 0 || breakdown.Components[0].Rule != "AGE:" {
+		t.Errorf("Bad breakdown: %v", breakdown)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// SLAKEY the service levels for priority labelled issues
+	SLAKEY = "/github.com/brotherlogic/githubcard/slas"
+)
+
+// validateSLAs checks that every sla has a label, a duration and a real channel
+func validateSLAs(config *pbgh.SLAConfig) error {
+	for _, sla := range config.GetSlas() {
+		if len(sla.GetLabel()) == 0 || sla.GetHours() <= 0 {
+			return fmt.Errorf("SLA for %v needs a label and positive hours", sla.GetRepo())
+		}
+		if len(sla.GetChannel()) > 0 {
+			if _, ok := pb.Card_Channel_value[sla.GetChannel()]; !ok {
+				return fmt.Errorf("Unknown channel %v", sla.GetChannel())
+			}
+		}
+	}
+	return nil
+}
+
+func escalationLabel(sla *pbgh.SLA) string {
+	if len(sla.GetEscalationLabel()) > 0 {
+		return sla.GetEscalationLabel()
+	}
+	return "escalated"
+}
+
+// breachedSLA returns the first sla the issue has broken, if any
+func (b *GithubBridge) breachedSLA(issue *pbgh.Issue, now time.Time) *pbgh.SLA {
+	for _, sla := range b.slas.GetSlas() {
+		if len(sla.GetRepo()) > 0 && sla.GetRepo() != issue.GetService() {
+			continue
+		}
+
+		for _, label := range issue.GetLabels() {
+			if label == sla.GetLabel() && now.Sub(time.Unix(issue.GetCreatedAt(), 0)) > time.Hour*time.Duration(sla.GetHours()) {
+				return sla
+			}
+		}
+	}
+	return nil
+}
+
+// escalateCard raises the card for an issue which has broken its sla
+func (b *GithubBridge) escalateCard(issue *pbgh.Issue, card *pb.Card, now time.Time) {
+	sla := b.breachedSLA(issue, now)
+	if sla == nil {
+		return
+	}
+
+	card.Priority += sla.GetPriorityBoost()
+	if len(sla.GetChannel()) > 0 {
+		card.Channel = pb.Card_Channel(pb.Card_Channel_value[sla.GetChannel()])
+	}
+}
+
+// escalate labels the issue and pings whoever owns it
+func (b *GithubBridge) escalate(issue *pbgh.Issue, sla *pbgh.SLA) error {
+	err := b.runAction(&cardAction{Action: "label", Issue: issue.GetUrl(), Labels: []string{escalationLabel(sla)}})
+	if err != nil {
+		return err
+	}
+
+	owner := issue.GetAssignee()
+	if len(owner) == 0 {
+		owner = "brotherlogic"
+	}
+	return b.runAction(&cardAction{Action: "comment", Issue: issue.GetUrl(),
+		Text: fmt.Sprintf("@%v this %v issue has been open for more than %v hours.", owner, sla.GetLabel(), sla.GetHours())})
+}
+
+// checkSLAs escalates every mirrored issue which has newly broken its sla, the
+// label change brings the issue through the next sync so its card gets refreshed
+func (b *GithubBridge) checkSLAs(ctx context.Context) {
+	if b.Registry == nil || !b.Registry.Master {
+		return
+	}
+
+	b.mirrorMutex.Lock()
+	breached := make(map[*pbgh.Issue]*pbgh.SLA)
+	for _, issue := range b.mirror.GetIssues() {
+		if sla := b.breachedSLA(issue, time.Now()); sla != nil {
+			escalated := false
+			for _, label := range issue.GetLabels() {
+				escalated = escalated || label == escalationLabel(sla)
+			}
+			if !escalated {
+				breached[issue] = sla
+			}
+		}
+	}
+	b.mirrorMutex.Unlock()
+
+	for issue, sla := range breached {
+		err := b.escalate(issue, sla)
+		b.auditURL(ctx, selfCaller, "check_slas", issue.GetUrl(), err)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to escalate %v: %v", issue.GetUrl(), err))
+			continue
+		}
+
+		b.mirrorMutex.Lock()
+		issue.Labels = append(issue.Labels, escalationLabel(sla))
+		b.mirrorMutex.Unlock()
+	}
+	b.saveMirror(ctx)
+}
+
+func (b *GithubBridge) readSLAs(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, SLAKEY, &pbgh.SLAConfig{})
+	if err != nil {
+		return err
+	}
+	b.slas = data.(*pbgh.SLAConfig)
+	return nil
+}
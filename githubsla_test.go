@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func slaTest() *GithubBridge {
+	s := InitTest()
+	s.slas = &pb.SLAConfig{Slas: []*pb.SLA{&pb.SLA{Label: "P0", Hours: 24, PriorityBoost: 50000, Channel: "ISSUES"}}}
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{
+		&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/12", Labels: []string{"P0"}, CreatedAt: time.Now().AddDate(0, 0, -2).Unix()},
+		&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/13", Labels: []string{"P0"}, CreatedAt: time.Now().Unix()},
+		&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/14", Labels: []string{"P1"}, CreatedAt: time.Now().AddDate(0, 0, -2).Unix()},
+	}}
+	return s
+}
+
+func TestBreachedSLA(t *testing.T) {
+	s := slaTest()
+
+	for i, expected := range []bool{true, false, false} {
+		if (s.breachedSLA(s.mirror.Issues[i], time.Now()) != nil) != expected {
+			t.Errorf("Bad sla check for %v", s.mirror.Issues[i])
+		}
+	}
+}
+
+func TestEscalatedCard(t *testing.T) {
+	s := slaTest()
+	channel := otherChannel()
+	s.slas.Slas[0].Channel = channel
+
+	breached := s.issueCard(s.mirror.Issues[0])
+	fresh := s.issueCard(s.mirror.Issues[1])
+
+	if breached.Priority-fresh.Priority < 50000 || breached.Channel.String() != channel || fresh.Channel != pbc.Card_ISSUES {
+		t.Errorf("Card was not escalated: %v vs %v", breached, fresh)
+	}
+}
+
+func TestCheckSLAs(t *testing.T) {
+	s := slaTest()
+
+	s.checkSLAs(context.Background())
+
+	labels := s.mirror.Issues[0].GetLabels()
+	if len(labels) != 2 || labels[1] != "escalated" {
+		t.Errorf("Issue was not escalated: %v", labels)
+	}
+	if len(s.mirror.Issues[1].GetLabels()) != 1 {
+		t.Errorf("Fresh issue was escalated: %v", s.mirror.Issues[1])
+	}
+}
+
+func TestSetSLAsBadChannel(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.SetSLAs(context.Background(), &pb.SLAConfig{Slas: []*pb.SLA{&pb.SLA{Label: "P0", Hours: 24, Channel: "NOT_A_CHANNEL"}}})
+	if err == nil {
+		t.Errorf("Bad channel was accepted")
+	}
+}
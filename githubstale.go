@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// STALEKEY the policies for marking and closing stale issues
+	STALEKEY = "/github.com/brotherlogic/githubcard/stale"
+
+	// Our own label and comment update the issue, so activity has to come a little after marking
+	staleSlack = time.Minute * 5
+)
+
+// validateStale checks that every policy gives a sensible timeline
+func validateStale(config *pbgh.StaleConfig) error {
+	for _, policy := range config.GetPolicies() {
+		if policy.GetStaleDays() <= 0 || policy.GetCloseDays() <= 0 {
+			return fmt.Errorf("Policy for %v needs positive stale and close days", policy.GetRepo())
+		}
+	}
+	return nil
+}
+
+func staleLabel(policy *pbgh.StalePolicy) string {
+	if len(policy.GetStaleLabel()) > 0 {
+		return policy.GetStaleLabel()
+	}
+	return "stale"
+}
+
+// stalePolicy picks the policy for an issue, the first matching policy wins
+func (b *GithubBridge) stalePolicy(issue *pbgh.Issue) *pbgh.StalePolicy {
+	for _, policy := range b.stale.GetPolicies() {
+		if len(policy.GetRepo()) == 0 || policy.GetRepo() == issue.GetService() {
+			return policy
+		}
+	}
+	return nil
+}
+
+// staleActions works out what the policies would do to the mirrored issues
+func (b *GithubBridge) staleActions(now time.Time) []*pbgh.StaleAction {
+	b.mirrorMutex.Lock()
+	defer b.mirrorMutex.Unlock()
+
+	actions := []*pbgh.StaleAction{}
+	for _, issue := range b.mirror.GetIssues() {
+		policy := b.stalePolicy(issue)
+		if policy == nil {
+			continue
+		}
+
+		labels := make(map[string]bool)
+		for _, label := range issue.GetLabels() {
+			labels[label] = true
+		}
+		exempt := false
+		for _, label := range policy.GetExemptLabels() {
+			exempt = exempt || labels[label]
+		}
+		if exempt || issue.GetSticky() {
+			continue
+		}
+
+		// The grace period runs from when we marked it, or the last update for issues marked by hand
+		idle := now.Sub(time.Unix(issue.GetUpdatedAt(), 0))
+		if labels[staleLabel(policy)] {
+			marked, ok := b.mirror.GetStaleMarked()[issue.GetUrl()]
+			if ok && time.Unix(issue.GetUpdatedAt(), 0).After(time.Unix(marked, 0).Add(staleSlack)) {
+				actions = append(actions, &pbgh.StaleAction{Issue: issue, Action: pbgh.StaleAction_UNMARK})
+				continue
+			}
+			if ok {
+				idle = now.Sub(time.Unix(marked, 0))
+			}
+			if idle > time.Hour*24*time.Duration(policy.GetCloseDays()) {
+				actions = append(actions, &pbgh.StaleAction{Issue: issue, Action: pbgh.StaleAction_CLOSE})
+			}
+		} else if idle > time.Hour*24*time.Duration(policy.GetStaleDays()) {
+			actions = append(actions, &pbgh.StaleAction{Issue: issue, Action: pbgh.StaleAction_MARK})
+		}
+	}
+	return actions
+}
+
+// runStaleAction marks or closes a stale issue on github
+func (b *GithubBridge) runStaleAction(action *pbgh.StaleAction) error {
+	issue := action.GetIssue()
+	policy := b.stalePolicy(issue)
+
+	switch action.GetAction() {
+	case pbgh.StaleAction_MARK:
+		err := b.runAction(&cardAction{Action: "label", Issue: issue.GetUrl(), Labels: []string{staleLabel(policy)}})
+		if err != nil {
+			return err
+		}
+		return b.runAction(&cardAction{Action: "comment", Issue: issue.GetUrl(),
+			Text: fmt.Sprintf("This issue has had no activity for %v days and will be closed in %v days unless something happens.", policy.GetStaleDays(), policy.GetCloseDays())})
+	case pbgh.StaleAction_CLOSE:
+		err := b.runAction(&cardAction{Action: "comment", Issue: issue.GetUrl(), Text: "Closing as stale."})
+		if err != nil {
+			return err
+		}
+		return b.runAction(&cardAction{Action: "close", Issue: issue.GetUrl()})
+	case pbgh.StaleAction_UNMARK:
+		data, _ := json.Marshal(map[string][]string{"labels": unmarkedLabels(issue, policy)})
+		return checkResponse(b.patchURL(issue.GetUrl(), string(data)))
+	}
+	return nil
+}
+
+// unmarkedLabels are the labels an issue keeps once it's no longer stale
+func unmarkedLabels(issue *pbgh.Issue, policy *pbgh.StalePolicy) []string {
+	labels := []string{}
+	for _, label := range issue.GetLabels() {
+		if label != staleLabel(policy) {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// applyStale runs the stale policies against the mirrored issues
+func (b *GithubBridge) applyStale(ctx context.Context) {
+	if b.Registry == nil || !b.Registry.Master {
+		return
+	}
+
+	for _, action := range b.staleActions(time.Now()) {
+		err := b.runStaleAction(action)
+		b.auditURL(ctx, selfCaller, "apply_stale", action.GetIssue().GetUrl(), err)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to %v %v: %v", action.GetAction(), action.GetIssue().GetUrl(), err))
+			continue
+		}
+
+		b.markedStale(action, time.Now())
+	}
+	b.saveMirror(ctx)
+}
+
+// markedStale keeps the mirror in step with a stale action we've run
+func (b *GithubBridge) markedStale(action *pbgh.StaleAction, now time.Time) {
+	issue := action.GetIssue()
+	if action.GetAction() == pbgh.StaleAction_CLOSE {
+		b.mirrorIssue(&pbgh.Issue{Url: issue.GetUrl(), State: pbgh.Issue_CLOSED})
+	}
+
+	b.mirrorMutex.Lock()
+	defer b.mirrorMutex.Unlock()
+	switch action.GetAction() {
+	case pbgh.StaleAction_MARK:
+		if b.mirror.StaleMarked == nil {
+			b.mirror.StaleMarked = make(map[string]int64)
+		}
+		b.mirror.StaleMarked[issue.GetUrl()] = now.Unix()
+		issue.Labels = append(issue.Labels, staleLabel(b.stalePolicy(issue)))
+	case pbgh.StaleAction_UNMARK:
+		delete(b.mirror.StaleMarked, issue.GetUrl())
+		issue.Labels = unmarkedLabels(issue, b.stalePolicy(issue))
+	case pbgh.StaleAction_CLOSE:
+		delete(b.mirror.StaleMarked, issue.GetUrl())
+	}
+}
+
+func (b *GithubBridge) readStale(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, STALEKEY, &pbgh.StaleConfig{})
+	if err != nil {
+		return err
+	}
+	b.stale = data.(*pbgh.StaleConfig)
+	return nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func staleTest() *GithubBridge {
+	s := InitTest()
+	s.stale = &pb.StaleConfig{Policies: []*pb.StalePolicy{
+		&pb.StalePolicy{Repo: "Home", StaleDays: 30, CloseDays: 7, ExemptLabels: []string{"keep"}},
+	}}
+	now := time.Now()
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{
+		&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/12", UpdatedAt: now.AddDate(0, 0, -40).Unix()},
+		&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/13", UpdatedAt: now.AddDate(0, 0, -40).Unix(), Labels: []string{"keep"}},
+		&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/14", UpdatedAt: now.AddDate(0, 0, -1).Unix()},
+		&pb.Issue{Service: "crasher", Url: "https://api.github.com/repos/brotherlogic/crasher/issues/15", UpdatedAt: now.AddDate(0, 0, -40).Unix()},
+	}}
+	return s
+}
+
+func TestStaleActions(t *testing.T) {
+	s := staleTest()
+	s.mirror.Issues = append(s.mirror.Issues, &pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/16", UpdatedAt: time.Now().AddDate(0, 0, -10).Unix(), Labels: []string{"stale"}})
+
+	actions := s.staleActions(time.Now())
+	if len(actions) != 2 {
+		t.Fatalf("Wrong number of actions: %v", actions)
+	}
+	if actions[0].Action != pb.StaleAction_MARK || actions[0].Issue.Url != "https://api.github.com/repos/brotherlogic/Home/issues/12" {
+		t.Errorf("Bad mark action: %v", actions[0])
+	}
+	if actions[1].Action != pb.StaleAction_CLOSE || actions[1].Issue.Url != "https://api.github.com/repos/brotherlogic/Home/issues/16" {
+		t.Errorf("Bad close action: %v", actions[1])
+	}
+}
+
+func TestStaleReportIsDryRun(t *testing.T) {
+	s := staleTest()
+	s.getter = failGetter{}
+
+	report, err := s.GetStaleReport(context.Background(), &pb.Empty{})
+	if err != nil {
+		t.Fatalf("Error getting report: %v", err)
+	}
+	if len(report.GetActions()) != 1 || len(s.mirror.GetIssues()) != 4 {
+		t.Errorf("Bad report: %v", report)
+	}
+}
+
+func TestApplyStale(t *testing.T) {
+	s := staleTest()
+	s.mirror.Issues[0].Labels = []string{"stale"}
+
+	s.applyStale(context.Background())
+
+	if len(s.mirror.GetIssues()) != 3 {
+		t.Errorf("Stale issue was not closed: %v", s.mirror.GetIssues())
+	}
+}
+
+func TestSetStalePoliciesBadDays(t *testing.T) {
+	s := InitTest()
+
+	_, err := s.SetStalePolicies(context.Background(), &pb.StaleConfig{Policies: []*pb.StalePolicy{&pb.StalePolicy{Repo: "Home", StaleDays: 30}}})
+	if err == nil {
+		t.Errorf("Policy with no close days was accepted")
+	}
+}
+
+func TestStaleUnmarkedOnActivity(t *testing.T) {
+	s := staleTest()
+	sent := []string{}
+	s.getter = recordingGetter{sent: &sent}
+	now := time.Now()
+	s.mirror.Issues = []*pb.Issue{&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/12", UpdatedAt: now.AddDate(0, 0, -1).Unix(), Labels: []string{"bug", "stale"}}}
+	s.mirror.StaleMarked = map[string]int64{"https://api.github.com/repos/brotherlogic/Home/issues/12": now.AddDate(0, 0, -10).Unix()}
+
+	actions := s.staleActions(now)
+	if len(actions) != 1 || actions[0].Action != pb.StaleAction_UNMARK {
+		t.Fatalf("Issue with new activity was not unmarked: %v", actions)
+	}
+
+	s.applyStale(context.Background())
+	if len(sent) != 1 || sent[0] != `{"labels":["bug"]}` {
+		t.Errorf("Stale label was not removed: %v", sent)
+	}
+	if len(s.mirror.GetStaleMarked()) != 0 || len(s.mirror.Issues[0].Labels) != 1 {
+		t.Errorf("Mirror still has the issue as stale: %v", s.mirror)
+	}
+}
+
+func TestStaleCloseCountsFromMark(t *testing.T) {
+	s := staleTest()
+	now := time.Now()
+	s.mirror.Issues = []*pb.Issue{&pb.Issue{Service: "Home", Url: "https://api.github.com/repos/brotherlogic/Home/issues/12", UpdatedAt: now.AddDate(0, 0, -40).Unix(), Labels: []string{"stale"}}}
+	s.mirror.StaleMarked = map[string]int64{"https://api.github.com/repos/brotherlogic/Home/issues/12": now.AddDate(0, 0, -2).Unix()}
+
+	if actions := s.staleActions(now); len(actions) != 0 {
+		t.Errorf("Issue was closed before its grace period ran out: %v", actions)
+	}
+	if actions := s.staleActions(now.AddDate(0, 0, 6)); len(actions) != 1 || actions[0].Action != pb.StaleAction_CLOSE {
+		t.Errorf("Issue was not closed after its grace period: %v", actions)
+	}
+}
+
+func TestStaleMarkRecorded(t *testing.T) {
+	s := staleTest()
+
+	s.applyStale(context.Background())
+
+	if _, ok := s.mirror.GetStaleMarked()["https://api.github.com/repos/brotherlogic/Home/issues/12"]; !ok {
+		t.Errorf("Marking was not recorded: %v", s.mirror)
+	}
+	if actions := s.staleActions(time.Now()); len(actions) != 0 {
+		t.Errorf("Our own marking counted as activity: %v", actions)
+	}
+}
@@ -1,16 +1,34 @@
 package main
 
 import (
+	"fmt"
+
 	"golang.org/x/net/context"
 )
 
+// procSticky drains the queue in order while github is up, stopping at the
+// first failure which might go through later
 func (g *GithubBridge) procSticky(ctx context.Context) {
-	for in, i := range g.issues {
-		_, err := g.AddIssueLocal("brotherlogic", i.GetService(), i.GetTitle(), i.GetBody())
+	for len(g.issues) > 0 && !g.circuitOpen() {
+		i := g.issues[0]
+
+		//Issues queued while github was down still carry their attachments
+		err := g.attachFiles(ctx, i)
 		if err == nil {
-			g.issues = append(g.issues[:in], g.issues[in+1:]...)
-			g.saveIssues(ctx)
-			return
+			i.Attachments = nil
+			err = g.fileIssue(ctx, i)
 		}
+		g.audit(ctx, selfCaller, "proc_sticky", i.GetService(), i.GetNumber(), err)
+
+		if err != nil {
+			if retryable(err) {
+				g.Log(fmt.Sprintf("Unable to file queued issue %v: %v", i.GetTitle(), err))
+				return
+			}
+			g.Log(fmt.Sprintf("Dropping queued issue %v: %v", i.GetTitle(), err))
+		}
+
+		g.issues = g.issues[1:]
+		g.saveIssues(ctx)
 	}
 }
@@ -16,3 +16,32 @@ func TestProcSticky(t *testing.T) {
 		t.Errorf("Issue was not added: %v", g.issues)
 	}
 }
+
+func TestProcStickyDrainsQueue(t *testing.T) {
+	g := InitTest()
+	g.issues = append(g.issues, &pb.Issue{Service: "Home", Title: "First", Body: "blah", Fingerprint: "home-first", Caller: "crasher"})
+	g.issues = append(g.issues, &pb.Issue{Service: "Home", Title: "Second", Body: "blah"})
+	g.procSticky(context.Background())
+
+	if len(g.issues) != 0 {
+		t.Errorf("Queue was not drained: %v", g.issues)
+	}
+	if len(g.tracked.GetIssues()) != 1 || g.tracked.GetIssues()[0].GetNumber() != 494 {
+		t.Errorf("Queued issue was not tracked: %v", g.tracked)
+	}
+	if g.lastFiled[filer{caller: "crasher", repo: "Home"}] != 494 {
+		t.Errorf("Queued issue was not recorded: %v", g.lastFiled)
+	}
+}
+
+func TestProcStickyKeepsQueueWhenDown(t *testing.T) {
+	g := InitTest()
+	g.getter = downGetter{}
+	g.issues = append(g.issues, &pb.Issue{Service: "Home", Title: "First", Body: "blah"})
+	g.issues = append(g.issues, &pb.Issue{Service: "Home", Title: "Second", Body: "blah"})
+	g.procSticky(context.Background())
+
+	if len(g.issues) != 2 || g.issues[0].GetTitle() != "First" {
+		t.Errorf("Queue was lost while github was down: %v", g.issues)
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+// watcher is a single WatchIssues stream
+type watcher struct {
+	issues map[string]bool
+	events chan *pb.IssueEvent
+}
+
+func issueKey(service string, number int32) string {
+	return fmt.Sprintf("%v/%v", service, number)
+}
+
+// issueEvents works out what has happened to an issue between two snapshots
+func issueEvents(old, latest *pb.Issue) []pb.IssueEvent_EventType {
+	events := []pb.IssueEvent_EventType{}
+
+	if latest.GetComments() > old.GetComments() {
+		events = append(events, pb.IssueEvent_COMMENTED)
+	}
+
+	labels := make(map[string]bool)
+	for _, l := range old.GetLabels() {
+		labels[l] = true
+	}
+	for _, l := range latest.GetLabels() {
+		if !labels[l] {
+			events = append(events, pb.IssueEvent_LABELLED)
+			break
+		}
+	}
+
+	if old.GetState() == pb.Issue_OPEN && latest.GetState() == pb.Issue_CLOSED {
+		events = append(events, pb.IssueEvent_CLOSED)
+	}
+	if old.GetState() == pb.Issue_CLOSED && latest.GetState() == pb.Issue_OPEN {
+		events = append(events, pb.IssueEvent_REOPENED)
+	}
+
+	return events
+}
+
+func (g *GithubBridge) addWatcher(issues []*pb.Issue) (int, *watcher) {
+	g.watchMutex.Lock()
+	defer g.watchMutex.Unlock()
+
+	w := &watcher{issues: make(map[string]bool), events: make(chan *pb.IssueEvent, 100)}
+	for _, issue := range issues {
+		w.issues[issueKey(issue.GetService(), issue.GetNumber())] = true
+	}
+
+	g.watchID++
+	g.watchers[g.watchID] = w
+	return g.watchID, w
+}
+
+func (g *GithubBridge) removeWatcher(id int) {
+	g.watchMutex.Lock()
+	defer g.watchMutex.Unlock()
+	delete(g.watchers, id)
+}
+
+// notify sends an event to everyone watching the issue
+func (g *GithubBridge) notify(issue *pb.Issue, eventType pb.IssueEvent_EventType) {
+	key := issueKey(issue.GetService(), issue.GetNumber())
+	event := &pb.IssueEvent{Type: eventType, Issue: issue, Timestamp: time.Now().Unix()}
+	for _, w := range g.watchers {
+		if w.issues[key] {
+			select {
+			case w.events <- event:
+			default:
+				g.Log(fmt.Sprintf("Dropping %v event for %v", eventType, key))
+			}
+		}
+	}
+}
+
+// updateWatched records the latest view of an issue and tells any watchers what changed
+func (g *GithubBridge) updateWatched(issue *pb.Issue) {
+	g.watchMutex.Lock()
+	defer g.watchMutex.Unlock()
+
+	key := issueKey(issue.GetService(), issue.GetNumber())
+	if old, ok := g.watched[key]; ok {
+		for _, eventType := range issueEvents(old, issue) {
+			g.notify(issue, eventType)
+		}
+	}
+	g.watched[key] = issue
+}
+
+func (g *GithubBridge) checkWatched(ctx context.Context) {
+	g.watchMutex.Lock()
+	keys := make(map[string]*pb.Issue)
+	for _, w := range g.watchers {
+		for key := range w.issues {
+			keys[key] = g.watched[key]
+		}
+	}
+	for key := range g.watched {
+		if _, ok := keys[key]; !ok {
+			delete(g.watched, key)
+		}
+	}
+	g.watchMutex.Unlock()
+
+	for _, issue := range keys {
+		if issue == nil {
+			continue
+		}
+		latest, err := g.GetIssueLocal("brotherlogic", issue.GetService(), int(issue.GetNumber()))
+		if err != nil {
+			g.Log(fmt.Sprintf("Unable to check %v: %v", issueKey(issue.GetService(), issue.GetNumber()), err))
+			continue
+		}
+		g.updateWatched(latest)
+	}
+}
+
+//WatchIssues streams changes to the given issues
+func (g *GithubBridge) WatchIssues(in *pb.WatchRequest, stream pb.Github_WatchIssuesServer) error {
+	countRPC(stream.Context(), "WatchIssues")
+	for _, issue := range in.GetIssues() {
+		if err := g.authorize(stream.Context(), "WatchIssues", issue.GetService()); err != nil {
+			return err
+		}
+	}
+
+	id, w := g.addWatcher(in.GetIssues())
+	defer g.removeWatcher(id)
+
+	for _, issue := range in.GetIssues() {
+		current, err := g.GetIssueLocal("brotherlogic", issue.GetService(), int(issue.GetNumber()))
+		if err != nil {
+			return err
+		}
+
+		g.watchMutex.Lock()
+		g.watched[issueKey(current.GetService(), current.GetNumber())] = current
+		g.watchMutex.Unlock()
+
+		eventType := pb.IssueEvent_OPENED
+		if current.GetState() == pb.Issue_CLOSED {
+			eventType = pb.IssueEvent_CLOSED
+		}
+		err = stream.Send(&pb.IssueEvent{Type: eventType, Issue: current, Timestamp: time.Now().Unix()})
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event := <-w.events:
+			err := stream.Send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
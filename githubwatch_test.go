@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+func TestIssueEvents(t *testing.T) {
+	old := &pb.Issue{Service: "Home", Number: 12, State: pb.Issue_OPEN, Comments: 1, Labels: []string{"bug"}}
+	latest := &pb.Issue{Service: "Home", Number: 12, State: pb.Issue_CLOSED, Comments: 2, Labels: []string{"bug", "wontfix"}}
+
+	events := issueEvents(old, latest)
+	if len(events) != 3 || events[0] != pb.IssueEvent_COMMENTED || events[1] != pb.IssueEvent_LABELLED || events[2] != pb.IssueEvent_CLOSED {
+		t.Errorf("Bad events: %v", events)
+	}
+
+	events = issueEvents(latest, old)
+	if len(events) != 1 || events[0] != pb.IssueEvent_REOPENED {
+		t.Errorf("Bad reopen events: %v", events)
+	}
+}
+
+func TestCheckWatched(t *testing.T) {
+	s := InitTest()
+	_, w := s.addWatcher([]*pb.Issue{&pb.Issue{Service: "Home", Number: 12}})
+	s.watched[issueKey("Home", 12)] = &pb.Issue{Service: "Home", Number: 12, State: pb.Issue_OPEN}
+
+	s.checkWatched(context.Background())
+
+	select {
+	case event := <-w.events:
+		if event.Type != pb.IssueEvent_CLOSED {
+			t.Errorf("Wrong event: %v", event)
+		}
+	default:
+		t.Errorf("No event sent")
+	}
+}
+
+func TestCheckWatchedDropsUnwatched(t *testing.T) {
+	s := InitTest()
+	id, _ := s.addWatcher([]*pb.Issue{&pb.Issue{Service: "Home", Number: 12}})
+	s.watched[issueKey("Home", 12)] = &pb.Issue{Service: "Home", Number: 12, State: pb.Issue_OPEN}
+	s.removeWatcher(id)
+
+	s.checkWatched(context.Background())
+
+	if len(s.watched) != 0 {
+		t.Errorf("Unwatched issue is still tracked: %v", s.watched)
+	}
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/cardserver/card"
+	pbgh "github.com/brotherlogic/githubcard/proto"
+)
+
+const (
+	// SECRETKEY the webhook secret
+	SECRETKEY = "/github.com/brotherlogic/githubcard/webhooksecret"
+
+	// WEBHOOKKEY the received webhook deliveries
+	WEBHOOKKEY = "/github.com/brotherlogic/githubcard/webhooks"
+
+	// How long we hold on to deliveries for replay
+	webhookRetention = time.Hour * 24 * 7
+
+	// Github caps webhook payloads at 25MB
+	maxWebhookSize = 25 * 1024 * 1024
+)
+
+// validSignature checks the X-Hub-Signature-256 header against the payload
+func validSignature(secret string, body []byte, signature string) bool {
+	if len(secret) == 0 || !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// seenDelivery finds a delivery we've been sent before and reports if it was processed,
+// a redelivery of something which failed gets another go
+func (b *GithubBridge) seenDelivery(id string) (*pbgh.WebhookDelivery, bool) {
+	b.webhookMutex.Lock()
+	defer b.webhookMutex.Unlock()
+
+	delivery, ok := b.deliveries[id]
+	return delivery, ok && delivery.GetProcessed()
+}
+
+func (b *GithubBridge) cleanDeliveries(ctx context.Context) {
+	b.webhookMutex.Lock()
+	defer b.webhookMutex.Unlock()
+
+	deliveries := []*pbgh.WebhookDelivery{}
+	for _, d := range b.webhookLog.GetDeliveries() {
+		if time.Now().Sub(time.Unix(d.GetTimestamp(), 0)) < webhookRetention {
+			deliveries = append(deliveries, d)
+		} else {
+			delete(b.deliveries, d.GetId())
+		}
+	}
+	if len(deliveries) != len(b.webhookLog.GetDeliveries()) {
+		b.webhookLog.Deliveries = deliveries
+		b.saveWebhookLog(ctx)
+	}
+}
+
+func (b *GithubBridge) webhookCount() int {
+	b.webhookMutex.Lock()
+	defer b.webhookMutex.Unlock()
+	return b.webhooks
+}
+
+// saveWebhookLog must be called with the webhook mutex held; every instance logs
+// deliveries, so we fold in what the others have stored rather than overwriting it
+func (b *GithubBridge) saveWebhookLog(ctx context.Context) {
+	data, _, err := b.KSclient.Read(ctx, WEBHOOKKEY, &pbgh.WebhookLog{})
+	if err == nil {
+		b.mergeDeliveries(data.(*pbgh.WebhookLog).GetDeliveries())
+	}
+	b.KSclient.Save(ctx, WEBHOOKKEY, b.webhookLog)
+}
+
+// mergeDeliveries adds deliveries logged elsewhere, must be called with the webhook mutex held
+func (b *GithubBridge) mergeDeliveries(deliveries []*pbgh.WebhookDelivery) {
+	for _, d := range deliveries {
+		if existing, ok := b.deliveries[d.GetId()]; ok {
+			existing.Processed = existing.GetProcessed() || d.GetProcessed()
+			continue
+		}
+		if time.Now().Sub(time.Unix(d.GetTimestamp(), 0)) < webhookRetention {
+			b.webhookLog.Deliveries = append(b.webhookLog.Deliveries, d)
+			b.deliveries[d.GetId()] = d
+		}
+	}
+}
+
+func (b *GithubBridge) readWebhookLog(ctx context.Context) error {
+	data, _, err := b.KSclient.Read(ctx, WEBHOOKKEY, &pbgh.WebhookLog{})
+	if err != nil {
+		return err
+	}
+
+	b.webhookMutex.Lock()
+	defer b.webhookMutex.Unlock()
+	b.mergeDeliveries(data.(*pbgh.WebhookLog).GetDeliveries())
+	return nil
+}
+
+// recordDelivery appends a delivery to the log
+func (b *GithubBridge) recordDelivery(ctx context.Context, delivery *pbgh.WebhookDelivery) {
+	b.webhookMutex.Lock()
+	defer b.webhookMutex.Unlock()
+	b.webhookLog.Deliveries = append(b.webhookLog.Deliveries, delivery)
+	b.deliveries[delivery.GetId()] = delivery
+	b.saveWebhookLog(ctx)
+}
+
+// processDelivery runs a logged delivery and marks it as done
+func (b *GithubBridge) processDelivery(ctx context.Context, delivery *pbgh.WebhookDelivery) error {
+	err := b.processWebhook(ctx, delivery.GetEvent(), delivery.GetPayload())
+	if err != nil {
+		return err
+	}
+
+	b.webhookMutex.Lock()
+	defer b.webhookMutex.Unlock()
+	delivery.Processed = true
+	b.saveWebhookLog(ctx)
+	return nil
+}
+
+// replayDeliveries re-processes logged deliveries received since the given time
+func (b *GithubBridge) replayDeliveries(ctx context.Context, since int64, unprocessedOnly bool) int {
+	b.webhookMutex.Lock()
+	deliveries := []*pbgh.WebhookDelivery{}
+	for _, d := range b.webhookLog.GetDeliveries() {
+		if d.GetTimestamp() >= since && (!unprocessedOnly || !d.GetProcessed()) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	b.webhookMutex.Unlock()
+
+	count := 0
+	for _, d := range deliveries {
+		err := b.processDelivery(ctx, d)
+		if err != nil {
+			b.Log(fmt.Sprintf("Unable to replay %v: %v", d.GetId(), err))
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// reconcile catches up on anything we missed while not master
+func (b *GithubBridge) reconcile(ctx context.Context) error {
+	b.replayDeliveries(ctx, 0, true)
+	b.checkWatched(ctx)
+	return b.passover()
+}
+
+func (b *GithubBridge) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !validSignature(b.webhookSecret, body, r.Header.Get("X-Hub-Signature-256")) {
+		b.Log(fmt.Sprintf("Rejecting webhook %v with bad signature", r.Header.Get("X-GitHub-Delivery")))
+		http.Error(w, "Bad signature", http.StatusUnauthorized)
+		return
+	}
+
+	delivery, processed := b.seenDelivery(r.Header.Get("X-GitHub-Delivery"))
+	if processed {
+		dedupHits.WithLabelValues("webhook").Inc()
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if delivery == nil {
+		delivery = &pbgh.WebhookDelivery{
+			Id:        r.Header.Get("X-GitHub-Delivery"),
+			Event:     r.Header.Get("X-GitHub-Event"),
+			Payload:   body,
+			Timestamp: time.Now().Unix(),
+		}
+		b.recordDelivery(r.Context(), delivery)
+	}
+
+	// Deliveries received when we're not master get picked up on promotion
+	if !b.Registry.Master {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	err = b.processDelivery(r.Context(), delivery)
+	if err != nil {
+		b.Log(fmt.Sprintf("Unable to process webhook: %v", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// processWebhook applies a github event to the card set and issue state
+func (b *GithubBridge) processWebhook(ctx context.Context, event string, body []byte) error {
+	var data map[string]interface{}
+	err := json.Unmarshal(body, &data)
+	if err != nil {
+		return err
+	}
+
+	b.webhookMutex.Lock()
+	b.webhooks++
+	b.webhookMutex.Unlock()
+
+	action, _ := data["action"].(string)
+	repo, ok := data["repository"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("No repository in %v event", event)
+	}
+	service, ok := repo["name"].(string)
+	if !ok {
+		return fmt.Errorf("No repository name in %v event", event)
+	}
+
+	switch event {
+	case "issues", "issue_comment":
+		issueMap, ok := data["issue"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("No issue in %v event", event)
+		}
+		issue, err := issueFromJSON(service, issueMap)
+		if err != nil {
+			return err
+		}
+		b.updateWatched(issue)
+
+		if _, ok := issueMap["pull_request"]; ok {
+			return nil
+		}
+
+		// A deleted comment leaves the issue alone
+		if event == "issues" && action == "deleted" {
+			issue.State = pbgh.Issue_CLOSED
+		}
+		changed := b.mirrorIssue(issue)
+		b.saveMirror(ctx)
+
+		card := b.issueCard(issue)
+		if issue.GetState() == pbgh.Issue_CLOSED {
+			_, err = b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: card.Hash})
+			return err
+		}
+
+		if changed {
+			_, err = b.cards.DeleteCards(ctx, &pb.DeleteRequest{Hash: card.Hash})
+			if err == nil {
+				_, err = b.cards.AddCards(ctx, &pb.CardList{Cards: []*pb.Card{card}})
+			}
+		}
+		return err
+	case "pull_request":
+		pr, ok := data["pull_request"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("No pull request in %v event", event)
+		}
+		pull, err := issueFromJSON(service, pr)
+		if err != nil {
+			return err
+		}
+		b.updateWatched(pull)
+		return b.refreshPullCard(ctx, repo, pr, pull)
+	}
+
+	return nil
+}
+
+// serveWebhooks takes github webhooks and serves our metrics
+func (b *GithubBridge) serveWebhooks(port int) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/webhook", b.handleWebhook)
+	mux.Handle("/metrics", promhttp.Handler())
+	err := http.ListenAndServe(fmt.Sprintf(":%v", port), mux)
+	if err != nil {
+		b.Log(fmt.Sprintf("Webhook server has failed: %v", err))
+	}
+}
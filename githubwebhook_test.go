@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/brotherlogic/keystore/client"
+
+	pbc "github.com/brotherlogic/cardserver/card"
+	pb "github.com/brotherlogic/githubcard/proto"
+)
+
+const testIssueEvent = `{"action": "closed", "issue": {"url": "https://api.github.com/repos/brotherlogic/Home/issues/12", "number": 12, "title": "Home Server / File Server", "body": "", "state": "closed", "labels": [], "comments": 0, "created_at": "2016-03-20T19:07:35Z"}, "repository": {"name": "Home"}}`
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func webhookRequest(body, signature, delivery string) *http.Request {
+	r := httptest.NewRequest("POST", "/webhook", bytes.NewBufferString(body))
+	r.Header.Set("X-Hub-Signature-256", signature)
+	r.Header.Set("X-GitHub-Delivery", delivery)
+	r.Header.Set("X-GitHub-Event", "issues")
+	return r
+}
+
+func TestWebhookBadSignature(t *testing.T) {
+	s := InitTest()
+	s.webhookSecret = "secret"
+
+	w := httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(testIssueEvent, sign("wrong", testIssueEvent), "1"))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Bad signature was accepted: %v", w.Code)
+	}
+}
+
+func TestWebhookNoSecret(t *testing.T) {
+	s := InitTest()
+
+	w := httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(testIssueEvent, sign("", testIssueEvent), "1"))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Webhook was accepted without a secret: %v", w.Code)
+	}
+}
+
+func TestWebhookClosesIssue(t *testing.T) {
+	s := InitTest()
+	s.webhookSecret = "secret"
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubissue-https://api.github.com/repos/brotherlogic/Home/issues/12"}}}
+	s.cards = cards
+	_, watch := s.addWatcher([]*pb.Issue{&pb.Issue{Service: "Home", Number: 12}})
+	s.watched[issueKey("Home", 12)] = &pb.Issue{Service: "Home", Number: 12, State: pb.Issue_OPEN}
+
+	w := httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "1"))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Webhook failed: %v -> %v", w.Code, w.Body.String())
+	}
+
+	if len(cards.cards) != 0 {
+		t.Errorf("Card was not removed: %v", cards.cards)
+	}
+
+	select {
+	case event := <-watch.events:
+		if event.Type != pb.IssueEvent_CLOSED {
+			t.Errorf("Wrong event: %v", event)
+		}
+	default:
+		t.Errorf("No event sent")
+	}
+}
+
+func TestWebhookDedup(t *testing.T) {
+	s := InitTest()
+	s.webhookSecret = "secret"
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		s.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "1"))
+		if w.Code != http.StatusOK {
+			t.Fatalf("Webhook failed: %v", w.Code)
+		}
+	}
+
+	if s.webhooks != 1 {
+		t.Errorf("Duplicate delivery was processed: %v", s.webhooks)
+	}
+}
+
+func TestWebhookNotMaster(t *testing.T) {
+	s := InitTest()
+	s.webhookSecret = "secret"
+	s.Registry.Master = false
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubissue-https://api.github.com/repos/brotherlogic/Home/issues/12"}}}
+	s.cards = cards
+
+	w := httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "1"))
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("Webhook was not queued: %v", w.Code)
+	}
+	if len(cards.cards) != 1 {
+		t.Fatalf("Webhook was processed when not master: %v", cards.cards)
+	}
+
+	s.Registry.Master = true
+	s.saveIssues(context.Background())
+	err := s.Mote(context.Background(), true)
+	if err != nil {
+		t.Fatalf("Error on promotion: %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if _, processed := s.seenDelivery("1"); processed {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	t.Errorf("Delivery was not processed on promotion: %v", s.webhookLog)
+}
+
+func TestMoteFreshInstall(t *testing.T) {
+	s := InitTest()
+	s.GoServer.KSclient = *keystoreclient.GetTestClient(".test_fresh")
+	s.saveIssues(context.Background())
+	s.cards = &testCardClient{fail: true}
+
+	err := s.Mote(context.Background(), true)
+	if err != nil {
+		t.Errorf("Unable to promote without a webhook log or cardserver: %v", err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	s := InitTest()
+	s.webhookSecret = "secret"
+
+	w := httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "1"))
+
+	resp, err := s.Replay(context.Background(), &pb.ReplayRequest{Since: 0})
+	if err != nil {
+		t.Fatalf("Error replaying: %v", err)
+	}
+
+	if resp.Replayed != 1 || s.webhooks != 2 {
+		t.Errorf("Delivery was not replayed: %v (%v)", resp, s.webhooks)
+	}
+
+	resp, err = s.Replay(context.Background(), &pb.ReplayRequest{Since: time.Now().Add(time.Hour).Unix()})
+	if err != nil || resp.Replayed != 0 {
+		t.Errorf("Future replay was wrong: %v, %v", resp, err)
+	}
+}
+
+func TestCleanDeliveries(t *testing.T) {
+	s := InitTest()
+	s.webhookLog.Deliveries = append(s.webhookLog.Deliveries, &pb.WebhookDelivery{Id: "old", Timestamp: time.Now().Add(-webhookRetention * 2).Unix()})
+	s.webhookLog.Deliveries = append(s.webhookLog.Deliveries, &pb.WebhookDelivery{Id: "new", Timestamp: time.Now().Unix()})
+
+	s.cleanDeliveries(context.Background())
+
+	if len(s.webhookLog.Deliveries) != 1 || s.webhookLog.Deliveries[0].Id != "new" {
+		t.Errorf("Deliveries were not cleaned: %v", s.webhookLog)
+	}
+}
+
+func TestWebhookRedeliveryAfterFailure(t *testing.T) {
+	s := InitTest()
+	s.webhookSecret = "secret"
+	cards := &testCardClient{cards: []*pbc.Card{&pbc.Card{Hash: "githubissue-https://api.github.com/repos/brotherlogic/Home/issues/12"}}, fail: true}
+	s.cards = cards
+
+	w := httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "1"))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("Failed delivery was accepted: %v", w.Code)
+	}
+
+	cards.fail = false
+	w = httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "1"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Redelivery failed: %v", w.Code)
+	}
+
+	if len(cards.cards) != 0 || len(s.webhookLog.GetDeliveries()) != 1 || !s.webhookLog.Deliveries[0].Processed {
+		t.Errorf("Redelivery was not processed: %v, %v", cards.cards, s.webhookLog)
+	}
+}
+
+func TestWebhookMalformed(t *testing.T) {
+	s := InitTest()
+
+	for _, body := range []string{
+		`{"action": "closed", "issue": {}, "repository": {"name": "Home"}}`,
+		`{"action": "closed", "issue": {"title": "Broken"}, "repository": {}}`,
+		`{"action": "closed", "issue": {"title": "Broken", "state": 12}, "repository": {"name": "Home"}}`,
+	} {
+		if err := s.processWebhook(context.Background(), "issues", []byte(body)); err == nil {
+			t.Errorf("Malformed payload was processed: %v", body)
+		}
+	}
+}
+
+const testPullEvent = `{"action": "%v", "pull_request": {"url": "https://api.github.com/repos/brotherlogic/Home/pulls/14", "issue_url": "https://api.github.com/repos/brotherlogic/Home/issues/14", "number": 14, "title": "Add a thing", "state": "%v", "requested_reviewers": [{"login": "brotherlogic"}], "user": {"login": "someone"}}, "repository": {"name": "Home", "url": "https://api.github.com/repos/brotherlogic/Home"}}`
+
+func TestWebhookPullCard(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{}
+	s.cards = cards
+
+	err := s.processWebhook(context.Background(), "pull_request", []byte(fmt.Sprintf(testPullEvent, "review_requested", "open")))
+	if err != nil {
+		t.Fatalf("Unable to process pull request: %v", err)
+	}
+	if len(cards.cards) != 1 || cards.cards[0].Hash != pullCardPrefix+"https://api.github.com/repos/brotherlogic/Home/issues/14" {
+		t.Fatalf("Pull card was not added: %v", cards.cards)
+	}
+
+	err = s.processWebhook(context.Background(), "pull_request", []byte(fmt.Sprintf(testPullEvent, "closed", "closed")))
+	if err != nil || len(cards.cards) != 0 {
+		t.Errorf("Pull card was not removed: %v, %v", cards.cards, err)
+	}
+}
+
+const testCommentEvent = `{"action": "created", "issue": {"url": "https://api.github.com/repos/brotherlogic/Home/issues/12", "number": 12, "title": "Home Server / File Server", "body": "", "state": "open", "labels": [], "comments": 3, "created_at": "2016-03-20T19:07:35Z", "updated_at": "2016-03-21T19:07:35Z"}, "comment": {"body": "Any news?"}, "repository": {"name": "Home"}}`
+
+func TestWebhookCommentUpdatesCard(t *testing.T) {
+	s := InitTest()
+	cards := &testCardClient{}
+	s.cards = cards
+	s.mirror = &pb.IssueMirror{Issues: []*pb.Issue{&pb.Issue{Service: "Home", Number: 12, Url: "https://api.github.com/repos/brotherlogic/Home/issues/12"}}}
+
+	err := s.processWebhook(context.Background(), "issue_comment", []byte(testCommentEvent))
+	if err != nil {
+		t.Fatalf("Unable to process comment: %v", err)
+	}
+
+	if s.mirror.GetIssues()[0].GetComments() != 3 {
+		t.Errorf("Comment count was not mirrored: %v", s.mirror)
+	}
+	if len(cards.cards) != 1 {
+		t.Errorf("Card was not refreshed: %v", cards.cards)
+	}
+}
+
+func TestWebhookTooLarge(t *testing.T) {
+	s := InitTest()
+	s.webhookSecret = "secret"
+
+	body := string(make([]byte, maxWebhookSize+1))
+	w := httptest.NewRecorder()
+	s.handleWebhook(w, webhookRequest(body, sign("secret", body), "1"))
+
+	if w.Code != http.StatusBadRequest || s.webhookCount() != 0 {
+		t.Errorf("Oversized webhook was accepted: %v", w.Code)
+	}
+}
+
+func TestWebhookLogSharedBetweenInstances(t *testing.T) {
+	master := InitTest()
+	master.webhookSecret = "secret"
+	other := InitTest()
+	other.webhookSecret = "secret"
+	other.Registry.Master = false
+	other.GoServer.KSclient = master.GoServer.KSclient
+
+	w := httptest.NewRecorder()
+	master.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "1"))
+	w = httptest.NewRecorder()
+	other.handleWebhook(w, webhookRequest(testIssueEvent, sign("secret", testIssueEvent), "2"))
+	w = httptest.NewRecorder()
+	master.handleWebhook(w, webhookRequest(testCommentEvent, sign("secret", testCommentEvent), "3"))
+
+	data, _, err := master.KSclient.Read(context.Background(), WEBHOOKKEY, &pb.WebhookLog{})
+	if err != nil {
+		t.Fatalf("Unable to read the log: %v", err)
+	}
+	if len(data.(*pb.WebhookLog).GetDeliveries()) != 3 {
+		t.Errorf("A delivery was lost: %v", data)
+	}
+	if _, processed := master.seenDelivery("2"); master.deliveries["2"] == nil || processed {
+		t.Errorf("Other instance's delivery was not picked up for processing: %v", master.deliveries)
+	}
+}
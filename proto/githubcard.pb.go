@@ -43,7 +43,132 @@ func (x Issue_IssueState) String() string {
 	return proto.EnumName(Issue_IssueState_name, int32(x))
 }
 func (Issue_IssueState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_githubcard_80558b1df39b95f8, []int{1, 0}
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{2, 0}
+}
+
+type Issue_PullRequestState int32
+
+const (
+	Issue_NONE             Issue_PullRequestState = 0
+	Issue_REVIEW_REQUESTED Issue_PullRequestState = 1
+	Issue_FAILING          Issue_PullRequestState = 2
+	Issue_AWAITING_MERGE   Issue_PullRequestState = 3
+)
+
+var Issue_PullRequestState_name = map[int32]string{
+	0: "NONE",
+	1: "REVIEW_REQUESTED",
+	2: "FAILING",
+	3: "AWAITING_MERGE",
+}
+var Issue_PullRequestState_value = map[string]int32{
+	"NONE":             0,
+	"REVIEW_REQUESTED": 1,
+	"FAILING":          2,
+	"AWAITING_MERGE":   3,
+}
+
+func (x Issue_PullRequestState) String() string {
+	return proto.EnumName(Issue_PullRequestState_name, int32(x))
+}
+func (Issue_PullRequestState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{2, 1}
+}
+
+type IssueEvent_EventType int32
+
+const (
+	IssueEvent_OPENED    IssueEvent_EventType = 0
+	IssueEvent_COMMENTED IssueEvent_EventType = 1
+	IssueEvent_LABELLED  IssueEvent_EventType = 2
+	IssueEvent_CLOSED    IssueEvent_EventType = 3
+	IssueEvent_REOPENED  IssueEvent_EventType = 4
+)
+
+var IssueEvent_EventType_name = map[int32]string{
+	0: "OPENED",
+	1: "COMMENTED",
+	2: "LABELLED",
+	3: "CLOSED",
+	4: "REOPENED",
+}
+var IssueEvent_EventType_value = map[string]int32{
+	"OPENED":    0,
+	"COMMENTED": 1,
+	"LABELLED":  2,
+	"CLOSED":    3,
+	"REOPENED":  4,
+}
+
+func (x IssueEvent_EventType) String() string {
+	return proto.EnumName(IssueEvent_EventType_name, int32(x))
+}
+func (IssueEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{8, 0}
+}
+
+type ScoringRule_Factor int32
+
+const (
+	ScoringRule_AGE                ScoringRule_Factor = 0
+	ScoringRule_LABEL              ScoringRule_Factor = 1
+	ScoringRule_COMMENTS           ScoringRule_Factor = 2
+	ScoringRule_REACTIONS          ScoringRule_Factor = 3
+	ScoringRule_ASSIGNEE           ScoringRule_Factor = 4
+	ScoringRule_REPO               ScoringRule_Factor = 5
+	ScoringRule_PULL_REQUEST_STATE ScoringRule_Factor = 6
+)
+
+var ScoringRule_Factor_name = map[int32]string{
+	0: "AGE",
+	1: "LABEL",
+	2: "COMMENTS",
+	3: "REACTIONS",
+	4: "ASSIGNEE",
+	5: "REPO",
+	6: "PULL_REQUEST_STATE",
+}
+var ScoringRule_Factor_value = map[string]int32{
+	"AGE":                0,
+	"LABEL":              1,
+	"COMMENTS":           2,
+	"REACTIONS":          3,
+	"ASSIGNEE":           4,
+	"REPO":               5,
+	"PULL_REQUEST_STATE": 6,
+}
+
+func (x ScoringRule_Factor) String() string {
+	return proto.EnumName(ScoringRule_Factor_name, int32(x))
+}
+func (ScoringRule_Factor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{13, 0}
+}
+
+type StaleAction_Action int32
+
+const (
+	StaleAction_MARK   StaleAction_Action = 0
+	StaleAction_CLOSE  StaleAction_Action = 1
+	StaleAction_UNMARK StaleAction_Action = 2
+)
+
+var StaleAction_Action_name = map[int32]string{
+	0: "MARK",
+	1: "CLOSE",
+	2: "UNMARK",
+}
+var StaleAction_Action_value = map[string]int32{
+	"MARK":   0,
+	"CLOSE":  1,
+	"UNMARK": 2,
+}
+
+func (x StaleAction_Action) String() string {
+	return proto.EnumName(StaleAction_Action_name, int32(x))
+}
+func (StaleAction_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{24, 0}
 }
 
 type Token struct {
@@ -57,7 +182,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_githubcard_80558b1df39b95f8, []int{0}
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{0}
 }
 func (m *Token) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Token.Unmarshal(m, b)
@@ -84,23 +209,73 @@ func (m *Token) GetToken() string {
 	return ""
 }
 
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{1}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (dst *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(dst, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
 type Issue struct {
-	Title                string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Body                 string           `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Service              string           `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Number               int32            `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
-	State                Issue_IssueState `protobuf:"varint,5,opt,name=state,proto3,enum=githubcard.Issue_IssueState" json:"state,omitempty"`
-	Sticky               bool             `protobuf:"varint,6,opt,name=sticky,proto3" json:"sticky,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body             string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Service          string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Number           int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	State            Issue_IssueState       `protobuf:"varint,5,opt,name=state,proto3,enum=githubcard.Issue_IssueState" json:"state,omitempty"`
+	Sticky           bool                   `protobuf:"varint,6,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	Labels           []string               `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	Comments         int32                  `protobuf:"varint,8,opt,name=comments,proto3" json:"comments,omitempty"`
+	Url              string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Assignee         string                 `protobuf:"bytes,12,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	Reactions        int32                  `protobuf:"varint,13,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	PullRequest      bool                   `protobuf:"varint,14,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	PullRequestState Issue_PullRequestState `protobuf:"varint,15,opt,name=pull_request_state,json=pullRequestState,proto3,enum=githubcard.Issue_PullRequestState" json:"pull_request_state,omitempty"`
+	// Identifies the problem so the filing service can later resolve it
+	Fingerprint string `protobuf:"bytes,16,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Structured details for rendering through a template
+	Host        string            `protobuf:"bytes,17,opt,name=host,proto3" json:"host,omitempty"`
+	Version     string            `protobuf:"bytes,18,opt,name=version,proto3" json:"version,omitempty"`
+	Error       string            `protobuf:"bytes,19,opt,name=error,proto3" json:"error,omitempty"`
+	Stack       string            `protobuf:"bytes,20,opt,name=stack,proto3" json:"stack,omitempty"`
+	Metadata    map[string]string `protobuf:"bytes,21,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Attachments []*Attachment     `protobuf:"bytes,22,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Who filed an issue that's waiting in the queue
+	Caller               string   `protobuf:"bytes,23,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Issue) Reset()         { *m = Issue{} }
 func (m *Issue) String() string { return proto.CompactTextString(m) }
 func (*Issue) ProtoMessage()    {}
 func (*Issue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_githubcard_80558b1df39b95f8, []int{1}
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{2}
 }
 func (m *Issue) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Issue.Unmarshal(m, b)
@@ -162,6 +337,218 @@ func (m *Issue) GetSticky() bool {
 	return false
 }
 
+func (m *Issue) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Issue) GetComments() int32 {
+	if m != nil {
+		return m.Comments
+	}
+	return 0
+}
+
+func (m *Issue) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Issue) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Issue) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Issue) GetAssignee() string {
+	if m != nil {
+		return m.Assignee
+	}
+	return ""
+}
+
+func (m *Issue) GetReactions() int32 {
+	if m != nil {
+		return m.Reactions
+	}
+	return 0
+}
+
+func (m *Issue) GetPullRequest() bool {
+	if m != nil {
+		return m.PullRequest
+	}
+	return false
+}
+
+func (m *Issue) GetPullRequestState() Issue_PullRequestState {
+	if m != nil {
+		return m.PullRequestState
+	}
+	return Issue_NONE
+}
+
+func (m *Issue) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *Issue) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *Issue) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Issue) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Issue) GetStack() string {
+	if m != nil {
+		return m.Stack
+	}
+	return ""
+}
+
+func (m *Issue) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Issue) GetAttachments() []*Attachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+func (m *Issue) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+type Attachment struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType          string   `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Attachment) Reset()         { *m = Attachment{} }
+func (m *Attachment) String() string { return proto.CompactTextString(m) }
+func (*Attachment) ProtoMessage()    {}
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{3}
+}
+func (m *Attachment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Attachment.Unmarshal(m, b)
+}
+func (m *Attachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Attachment.Marshal(b, m, deterministic)
+}
+func (dst *Attachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attachment.Merge(dst, src)
+}
+func (m *Attachment) XXX_Size() int {
+	return xxx_messageInfo_Attachment.Size(m)
+}
+func (m *Attachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attachment proto.InternalMessageInfo
+
+func (m *Attachment) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Attachment) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *Attachment) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+type AttachmentIndex struct {
+	// Content hash to the gist holding it
+	Gists                map[string]string `protobuf:"bytes,1,rep,name=gists,proto3" json:"gists,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AttachmentIndex) Reset()         { *m = AttachmentIndex{} }
+func (m *AttachmentIndex) String() string { return proto.CompactTextString(m) }
+func (*AttachmentIndex) ProtoMessage()    {}
+func (*AttachmentIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{4}
+}
+func (m *AttachmentIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachmentIndex.Unmarshal(m, b)
+}
+func (m *AttachmentIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachmentIndex.Marshal(b, m, deterministic)
+}
+func (dst *AttachmentIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentIndex.Merge(dst, src)
+}
+func (m *AttachmentIndex) XXX_Size() int {
+	return xxx_messageInfo_AttachmentIndex.Size(m)
+}
+func (m *AttachmentIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentIndex proto.InternalMessageInfo
+
+func (m *AttachmentIndex) GetGists() map[string]string {
+	if m != nil {
+		return m.Gists
+	}
+	return nil
+}
+
 type IssueList struct {
 	Issues               []*Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -173,7 +560,7 @@ func (m *IssueList) Reset()         { *m = IssueList{} }
 func (m *IssueList) String() string { return proto.CompactTextString(m) }
 func (*IssueList) ProtoMessage()    {}
 func (*IssueList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_githubcard_80558b1df39b95f8, []int{2}
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{5}
 }
 func (m *IssueList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IssueList.Unmarshal(m, b)
@@ -200,63 +587,2398 @@ func (m *IssueList) GetIssues() []*Issue {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Token)(nil), "githubcard.Token")
-	proto.RegisterType((*Issue)(nil), "githubcard.Issue")
-	proto.RegisterType((*IssueList)(nil), "githubcard.IssueList")
-	proto.RegisterEnum("githubcard.Issue_IssueState", Issue_IssueState_name, Issue_IssueState_value)
+type IssueMirror struct {
+	Issues   []*Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	LastSync int64    `protobuf:"varint,2,opt,name=last_sync,json=lastSync,proto3" json:"last_sync,omitempty"`
+	// Issue url to the time the snooze runs out
+	Snoozed map[string]int64 `protobuf:"bytes,3,rep,name=snoozed,proto3" json:"snoozed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Issue url to when we marked it as stale
+	StaleMarked          map[string]int64 `protobuf:"bytes,4,rep,name=stale_marked,json=staleMarked,proto3" json:"stale_marked,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// GithubClient is the client API for Github service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GithubClient interface {
-	AddIssue(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error)
-	Get(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error)
+func (m *IssueMirror) Reset()         { *m = IssueMirror{} }
+func (m *IssueMirror) String() string { return proto.CompactTextString(m) }
+func (*IssueMirror) ProtoMessage()    {}
+func (*IssueMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{6}
+}
+func (m *IssueMirror) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssueMirror.Unmarshal(m, b)
+}
+func (m *IssueMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssueMirror.Marshal(b, m, deterministic)
+}
+func (dst *IssueMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueMirror.Merge(dst, src)
+}
+func (m *IssueMirror) XXX_Size() int {
+	return xxx_messageInfo_IssueMirror.Size(m)
+}
+func (m *IssueMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueMirror.DiscardUnknown(m)
 }
 
-type githubClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_IssueMirror proto.InternalMessageInfo
+
+func (m *IssueMirror) GetIssues() []*Issue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
 }
 
-func NewGithubClient(cc *grpc.ClientConn) GithubClient {
-	return &githubClient{cc}
+func (m *IssueMirror) GetLastSync() int64 {
+	if m != nil {
+		return m.LastSync
+	}
+	return 0
 }
 
-func (c *githubClient) AddIssue(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error) {
-	out := new(Issue)
-	err := c.cc.Invoke(ctx, "/githubcard.Github/AddIssue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IssueMirror) GetSnoozed() map[string]int64 {
+	if m != nil {
+		return m.Snoozed
 	}
-	return out, nil
+	return nil
 }
 
-func (c *githubClient) Get(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error) {
-	out := new(Issue)
-	err := c.cc.Invoke(ctx, "/githubcard.Github/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IssueMirror) GetStaleMarked() map[string]int64 {
+	if m != nil {
+		return m.StaleMarked
 	}
-	return out, nil
+	return nil
 }
 
-// GithubServer is the server API for Github service.
-type GithubServer interface {
-	AddIssue(context.Context, *Issue) (*Issue, error)
-	Get(context.Context, *Issue) (*Issue, error)
+type WatchRequest struct {
+	Issues               []*Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterGithubServer(s *grpc.Server, srv GithubServer) {
-	s.RegisterService(&_Github_serviceDesc, srv)
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{7}
+}
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(dst, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetIssues() []*Issue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+type IssueEvent struct {
+	Type                 IssueEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=githubcard.IssueEvent_EventType" json:"type,omitempty"`
+	Issue                *Issue               `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	Timestamp            int64                `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *IssueEvent) Reset()         { *m = IssueEvent{} }
+func (m *IssueEvent) String() string { return proto.CompactTextString(m) }
+func (*IssueEvent) ProtoMessage()    {}
+func (*IssueEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{8}
+}
+func (m *IssueEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssueEvent.Unmarshal(m, b)
+}
+func (m *IssueEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssueEvent.Marshal(b, m, deterministic)
+}
+func (dst *IssueEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueEvent.Merge(dst, src)
+}
+func (m *IssueEvent) XXX_Size() int {
+	return xxx_messageInfo_IssueEvent.Size(m)
+}
+func (m *IssueEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueEvent proto.InternalMessageInfo
+
+func (m *IssueEvent) GetType() IssueEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return IssueEvent_OPENED
+}
+
+func (m *IssueEvent) GetIssue() *Issue {
+	if m != nil {
+		return m.Issue
+	}
+	return nil
+}
+
+func (m *IssueEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event                string   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Processed            bool     `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhookDelivery) Reset()         { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()    {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{9}
+}
+func (m *WebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDelivery.Unmarshal(m, b)
+}
+func (m *WebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDelivery.Marshal(b, m, deterministic)
+}
+func (dst *WebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDelivery.Merge(dst, src)
+}
+func (m *WebhookDelivery) XXX_Size() int {
+	return xxx_messageInfo_WebhookDelivery.Size(m)
+}
+func (m *WebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDelivery proto.InternalMessageInfo
+
+func (m *WebhookDelivery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetProcessed() bool {
+	if m != nil {
+		return m.Processed
+	}
+	return false
+}
+
+type WebhookLog struct {
+	Deliveries           []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *WebhookLog) Reset()         { *m = WebhookLog{} }
+func (m *WebhookLog) String() string { return proto.CompactTextString(m) }
+func (*WebhookLog) ProtoMessage()    {}
+func (*WebhookLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{10}
+}
+func (m *WebhookLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookLog.Unmarshal(m, b)
+}
+func (m *WebhookLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookLog.Marshal(b, m, deterministic)
+}
+func (dst *WebhookLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookLog.Merge(dst, src)
+}
+func (m *WebhookLog) XXX_Size() int {
+	return xxx_messageInfo_WebhookLog.Size(m)
+}
+func (m *WebhookLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookLog proto.InternalMessageInfo
+
+func (m *WebhookLog) GetDeliveries() []*WebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+type ReplayRequest struct {
+	Since                int64    `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayRequest) Reset()         { *m = ReplayRequest{} }
+func (m *ReplayRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayRequest) ProtoMessage()    {}
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{11}
+}
+func (m *ReplayRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplayRequest.Unmarshal(m, b)
+}
+func (m *ReplayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplayRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReplayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayRequest.Merge(dst, src)
+}
+func (m *ReplayRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplayRequest.Size(m)
+}
+func (m *ReplayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayRequest proto.InternalMessageInfo
+
+func (m *ReplayRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type ReplayResponse struct {
+	Replayed             int32    `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayResponse) Reset()         { *m = ReplayResponse{} }
+func (m *ReplayResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayResponse) ProtoMessage()    {}
+func (*ReplayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{12}
+}
+func (m *ReplayResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplayResponse.Unmarshal(m, b)
+}
+func (m *ReplayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplayResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReplayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayResponse.Merge(dst, src)
+}
+func (m *ReplayResponse) XXX_Size() int {
+	return xxx_messageInfo_ReplayResponse.Size(m)
+}
+func (m *ReplayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayResponse proto.InternalMessageInfo
+
+func (m *ReplayResponse) GetReplayed() int32 {
+	if m != nil {
+		return m.Replayed
+	}
+	return 0
+}
+
+type ScoringRule struct {
+	Factor ScoringRule_Factor `protobuf:"varint,1,opt,name=factor,proto3,enum=githubcard.ScoringRule_Factor" json:"factor,omitempty"`
+	// The label, assignee, repo or pull request state this rule applies to
+	Match string `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+	// Added once for matches, or per day / comment / reaction
+	Weight int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Rules apply to either issue cards or pull request cards
+	PullRequests         bool     `protobuf:"varint,4,opt,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScoringRule) Reset()         { *m = ScoringRule{} }
+func (m *ScoringRule) String() string { return proto.CompactTextString(m) }
+func (*ScoringRule) ProtoMessage()    {}
+func (*ScoringRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{13}
+}
+func (m *ScoringRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoringRule.Unmarshal(m, b)
+}
+func (m *ScoringRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoringRule.Marshal(b, m, deterministic)
+}
+func (dst *ScoringRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoringRule.Merge(dst, src)
+}
+func (m *ScoringRule) XXX_Size() int {
+	return xxx_messageInfo_ScoringRule.Size(m)
+}
+func (m *ScoringRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoringRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoringRule proto.InternalMessageInfo
+
+func (m *ScoringRule) GetFactor() ScoringRule_Factor {
+	if m != nil {
+		return m.Factor
+	}
+	return ScoringRule_AGE
+}
+
+func (m *ScoringRule) GetMatch() string {
+	if m != nil {
+		return m.Match
+	}
+	return ""
+}
+
+func (m *ScoringRule) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ScoringRule) GetPullRequests() bool {
+	if m != nil {
+		return m.PullRequests
+	}
+	return false
+}
+
+type ScoringConfig struct {
+	Rules                []*ScoringRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ScoringConfig) Reset()         { *m = ScoringConfig{} }
+func (m *ScoringConfig) String() string { return proto.CompactTextString(m) }
+func (*ScoringConfig) ProtoMessage()    {}
+func (*ScoringConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{14}
+}
+func (m *ScoringConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoringConfig.Unmarshal(m, b)
+}
+func (m *ScoringConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoringConfig.Marshal(b, m, deterministic)
+}
+func (dst *ScoringConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoringConfig.Merge(dst, src)
+}
+func (m *ScoringConfig) XXX_Size() int {
+	return xxx_messageInfo_ScoringConfig.Size(m)
+}
+func (m *ScoringConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoringConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoringConfig proto.InternalMessageInfo
+
+func (m *ScoringConfig) GetRules() []*ScoringRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type ScoreComponent struct {
+	Rule                 string   `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Score                int32    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScoreComponent) Reset()         { *m = ScoreComponent{} }
+func (m *ScoreComponent) String() string { return proto.CompactTextString(m) }
+func (*ScoreComponent) ProtoMessage()    {}
+func (*ScoreComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{15}
+}
+func (m *ScoreComponent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreComponent.Unmarshal(m, b)
+}
+func (m *ScoreComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreComponent.Marshal(b, m, deterministic)
+}
+func (dst *ScoreComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreComponent.Merge(dst, src)
+}
+func (m *ScoreComponent) XXX_Size() int {
+	return xxx_messageInfo_ScoreComponent.Size(m)
+}
+func (m *ScoreComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreComponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreComponent proto.InternalMessageInfo
+
+func (m *ScoreComponent) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *ScoreComponent) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type ScoreBreakdown struct {
+	Total                int32             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Components           []*ScoreComponent `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ScoreBreakdown) Reset()         { *m = ScoreBreakdown{} }
+func (m *ScoreBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ScoreBreakdown) ProtoMessage()    {}
+func (*ScoreBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{16}
+}
+func (m *ScoreBreakdown) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreBreakdown.Unmarshal(m, b)
+}
+func (m *ScoreBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreBreakdown.Marshal(b, m, deterministic)
+}
+func (dst *ScoreBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreBreakdown.Merge(dst, src)
+}
+func (m *ScoreBreakdown) XXX_Size() int {
+	return xxx_messageInfo_ScoreBreakdown.Size(m)
+}
+func (m *ScoreBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreBreakdown proto.InternalMessageInfo
+
+func (m *ScoreBreakdown) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ScoreBreakdown) GetComponents() []*ScoreComponent {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+type ChannelRule struct {
+	// Empty fields match everything
+	Repo         string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Label        string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Assignee     string `protobuf:"bytes,3,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	TitlePattern string `protobuf:"bytes,4,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"`
+	// The cardserver channel name, e.g. ISSUES
+	Channel string `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Rules apply to either issue cards or pull request cards
+	PullRequests         bool     `protobuf:"varint,6,opt,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelRule) Reset()         { *m = ChannelRule{} }
+func (m *ChannelRule) String() string { return proto.CompactTextString(m) }
+func (*ChannelRule) ProtoMessage()    {}
+func (*ChannelRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{17}
+}
+func (m *ChannelRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelRule.Unmarshal(m, b)
+}
+func (m *ChannelRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelRule.Marshal(b, m, deterministic)
+}
+func (dst *ChannelRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRule.Merge(dst, src)
+}
+func (m *ChannelRule) XXX_Size() int {
+	return xxx_messageInfo_ChannelRule.Size(m)
+}
+func (m *ChannelRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRule proto.InternalMessageInfo
+
+func (m *ChannelRule) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *ChannelRule) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ChannelRule) GetAssignee() string {
+	if m != nil {
+		return m.Assignee
+	}
+	return ""
+}
+
+func (m *ChannelRule) GetTitlePattern() string {
+	if m != nil {
+		return m.TitlePattern
+	}
+	return ""
+}
+
+func (m *ChannelRule) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelRule) GetPullRequests() bool {
+	if m != nil {
+		return m.PullRequests
+	}
+	return false
+}
+
+type ChannelConfig struct {
+	Rules                []*ChannelRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelConfig) Reset()         { *m = ChannelConfig{} }
+func (m *ChannelConfig) String() string { return proto.CompactTextString(m) }
+func (*ChannelConfig) ProtoMessage()    {}
+func (*ChannelConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{18}
+}
+func (m *ChannelConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelConfig.Unmarshal(m, b)
+}
+func (m *ChannelConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelConfig.Marshal(b, m, deterministic)
+}
+func (dst *ChannelConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelConfig.Merge(dst, src)
+}
+func (m *ChannelConfig) XXX_Size() int {
+	return xxx_messageInfo_ChannelConfig.Size(m)
+}
+func (m *ChannelConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelConfig proto.InternalMessageInfo
+
+func (m *ChannelConfig) GetRules() []*ChannelRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type BuildWatch struct {
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Also file an issue while the default branch is failing
+	FileIssue            bool     `protobuf:"varint,2,opt,name=file_issue,json=fileIssue,proto3" json:"file_issue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildWatch) Reset()         { *m = BuildWatch{} }
+func (m *BuildWatch) String() string { return proto.CompactTextString(m) }
+func (*BuildWatch) ProtoMessage()    {}
+func (*BuildWatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{19}
+}
+func (m *BuildWatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildWatch.Unmarshal(m, b)
+}
+func (m *BuildWatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildWatch.Marshal(b, m, deterministic)
+}
+func (dst *BuildWatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildWatch.Merge(dst, src)
+}
+func (m *BuildWatch) XXX_Size() int {
+	return xxx_messageInfo_BuildWatch.Size(m)
+}
+func (m *BuildWatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildWatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildWatch proto.InternalMessageInfo
+
+func (m *BuildWatch) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *BuildWatch) GetFileIssue() bool {
+	if m != nil {
+		return m.FileIssue
+	}
+	return false
+}
+
+type BuildConfig struct {
+	Repos                []*BuildWatch `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BuildConfig) Reset()         { *m = BuildConfig{} }
+func (m *BuildConfig) String() string { return proto.CompactTextString(m) }
+func (*BuildConfig) ProtoMessage()    {}
+func (*BuildConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{20}
+}
+func (m *BuildConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildConfig.Unmarshal(m, b)
+}
+func (m *BuildConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildConfig.Marshal(b, m, deterministic)
+}
+func (dst *BuildConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildConfig.Merge(dst, src)
+}
+func (m *BuildConfig) XXX_Size() int {
+	return xxx_messageInfo_BuildConfig.Size(m)
+}
+func (m *BuildConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildConfig proto.InternalMessageInfo
+
+func (m *BuildConfig) GetRepos() []*BuildWatch {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+type NotificationState struct {
+	// Notification threads we currently have cards up for
+	Threads              []string `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationState) Reset()         { *m = NotificationState{} }
+func (m *NotificationState) String() string { return proto.CompactTextString(m) }
+func (*NotificationState) ProtoMessage()    {}
+func (*NotificationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{21}
+}
+func (m *NotificationState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationState.Unmarshal(m, b)
+}
+func (m *NotificationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationState.Marshal(b, m, deterministic)
+}
+func (dst *NotificationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationState.Merge(dst, src)
+}
+func (m *NotificationState) XXX_Size() int {
+	return xxx_messageInfo_NotificationState.Size(m)
+}
+func (m *NotificationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationState proto.InternalMessageInfo
+
+func (m *NotificationState) GetThreads() []string {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+type StalePolicy struct {
+	// Empty matches every repo, the first matching policy wins
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Days without activity before an issue is marked stale
+	StaleDays int32 `protobuf:"varint,2,opt,name=stale_days,json=staleDays,proto3" json:"stale_days,omitempty"`
+	// Days after being marked before a stale issue is closed
+	CloseDays    int32    `protobuf:"varint,3,opt,name=close_days,json=closeDays,proto3" json:"close_days,omitempty"`
+	ExemptLabels []string `protobuf:"bytes,4,rep,name=exempt_labels,json=exemptLabels,proto3" json:"exempt_labels,omitempty"`
+	// Defaults to "stale"
+	StaleLabel           string   `protobuf:"bytes,5,opt,name=stale_label,json=staleLabel,proto3" json:"stale_label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StalePolicy) Reset()         { *m = StalePolicy{} }
+func (m *StalePolicy) String() string { return proto.CompactTextString(m) }
+func (*StalePolicy) ProtoMessage()    {}
+func (*StalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{22}
+}
+func (m *StalePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StalePolicy.Unmarshal(m, b)
+}
+func (m *StalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StalePolicy.Marshal(b, m, deterministic)
+}
+func (dst *StalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StalePolicy.Merge(dst, src)
+}
+func (m *StalePolicy) XXX_Size() int {
+	return xxx_messageInfo_StalePolicy.Size(m)
+}
+func (m *StalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StalePolicy proto.InternalMessageInfo
+
+func (m *StalePolicy) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *StalePolicy) GetStaleDays() int32 {
+	if m != nil {
+		return m.StaleDays
+	}
+	return 0
+}
+
+func (m *StalePolicy) GetCloseDays() int32 {
+	if m != nil {
+		return m.CloseDays
+	}
+	return 0
+}
+
+func (m *StalePolicy) GetExemptLabels() []string {
+	if m != nil {
+		return m.ExemptLabels
+	}
+	return nil
+}
+
+func (m *StalePolicy) GetStaleLabel() string {
+	if m != nil {
+		return m.StaleLabel
+	}
+	return ""
+}
+
+type StaleConfig struct {
+	Policies             []*StalePolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StaleConfig) Reset()         { *m = StaleConfig{} }
+func (m *StaleConfig) String() string { return proto.CompactTextString(m) }
+func (*StaleConfig) ProtoMessage()    {}
+func (*StaleConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{23}
+}
+func (m *StaleConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StaleConfig.Unmarshal(m, b)
+}
+func (m *StaleConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StaleConfig.Marshal(b, m, deterministic)
+}
+func (dst *StaleConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleConfig.Merge(dst, src)
+}
+func (m *StaleConfig) XXX_Size() int {
+	return xxx_messageInfo_StaleConfig.Size(m)
+}
+func (m *StaleConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleConfig proto.InternalMessageInfo
+
+func (m *StaleConfig) GetPolicies() []*StalePolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type StaleAction struct {
+	Issue                *Issue             `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Action               StaleAction_Action `protobuf:"varint,2,opt,name=action,proto3,enum=githubcard.StaleAction_Action" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StaleAction) Reset()         { *m = StaleAction{} }
+func (m *StaleAction) String() string { return proto.CompactTextString(m) }
+func (*StaleAction) ProtoMessage()    {}
+func (*StaleAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{24}
+}
+func (m *StaleAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StaleAction.Unmarshal(m, b)
+}
+func (m *StaleAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StaleAction.Marshal(b, m, deterministic)
+}
+func (dst *StaleAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleAction.Merge(dst, src)
+}
+func (m *StaleAction) XXX_Size() int {
+	return xxx_messageInfo_StaleAction.Size(m)
+}
+func (m *StaleAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleAction proto.InternalMessageInfo
+
+func (m *StaleAction) GetIssue() *Issue {
+	if m != nil {
+		return m.Issue
+	}
+	return nil
+}
+
+func (m *StaleAction) GetAction() StaleAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return StaleAction_MARK
+}
+
+type StaleReport struct {
+	Actions              []*StaleAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StaleReport) Reset()         { *m = StaleReport{} }
+func (m *StaleReport) String() string { return proto.CompactTextString(m) }
+func (*StaleReport) ProtoMessage()    {}
+func (*StaleReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{25}
+}
+func (m *StaleReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StaleReport.Unmarshal(m, b)
+}
+func (m *StaleReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StaleReport.Marshal(b, m, deterministic)
+}
+func (dst *StaleReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleReport.Merge(dst, src)
+}
+func (m *StaleReport) XXX_Size() int {
+	return xxx_messageInfo_StaleReport.Size(m)
+}
+func (m *StaleReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleReport proto.InternalMessageInfo
+
+func (m *StaleReport) GetActions() []*StaleAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type SLA struct {
+	// The priority label this applies to, e.g. P0
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Empty matches every repo
+	Repo string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// How long an issue may stay open
+	Hours int32 `protobuf:"varint,3,opt,name=hours,proto3" json:"hours,omitempty"`
+	// Defaults to "escalated"
+	EscalationLabel string `protobuf:"bytes,4,opt,name=escalation_label,json=escalationLabel,proto3" json:"escalation_label,omitempty"`
+	// Added to the card priority once breached
+	PriorityBoost int32 `protobuf:"varint,5,opt,name=priority_boost,json=priorityBoost,proto3" json:"priority_boost,omitempty"`
+	// Moves the card to this cardserver channel once breached, if set
+	Channel              string   `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SLA) Reset()         { *m = SLA{} }
+func (m *SLA) String() string { return proto.CompactTextString(m) }
+func (*SLA) ProtoMessage()    {}
+func (*SLA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{26}
+}
+func (m *SLA) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLA.Unmarshal(m, b)
+}
+func (m *SLA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLA.Marshal(b, m, deterministic)
+}
+func (dst *SLA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLA.Merge(dst, src)
+}
+func (m *SLA) XXX_Size() int {
+	return xxx_messageInfo_SLA.Size(m)
+}
+func (m *SLA) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLA.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLA proto.InternalMessageInfo
+
+func (m *SLA) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SLA) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *SLA) GetHours() int32 {
+	if m != nil {
+		return m.Hours
+	}
+	return 0
+}
+
+func (m *SLA) GetEscalationLabel() string {
+	if m != nil {
+		return m.EscalationLabel
+	}
+	return ""
+}
+
+func (m *SLA) GetPriorityBoost() int32 {
+	if m != nil {
+		return m.PriorityBoost
+	}
+	return 0
+}
+
+func (m *SLA) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type SLAConfig struct {
+	Slas                 []*SLA   `protobuf:"bytes,1,rep,name=slas,proto3" json:"slas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SLAConfig) Reset()         { *m = SLAConfig{} }
+func (m *SLAConfig) String() string { return proto.CompactTextString(m) }
+func (*SLAConfig) ProtoMessage()    {}
+func (*SLAConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{27}
+}
+func (m *SLAConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLAConfig.Unmarshal(m, b)
+}
+func (m *SLAConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLAConfig.Marshal(b, m, deterministic)
+}
+func (dst *SLAConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLAConfig.Merge(dst, src)
+}
+func (m *SLAConfig) XXX_Size() int {
+	return xxx_messageInfo_SLAConfig.Size(m)
+}
+func (m *SLAConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLAConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLAConfig proto.InternalMessageInfo
+
+func (m *SLAConfig) GetSlas() []*SLA {
+	if m != nil {
+		return m.Slas
+	}
+	return nil
+}
+
+type TrackedIssue struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Service     string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Number      int32  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	// When the service first reported recovery, zero if it hasn't
+	ResolvedAt           int64    `protobuf:"varint,4,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	QuietPeriod          int64    `protobuf:"varint,5,opt,name=quiet_period,json=quietPeriod,proto3" json:"quiet_period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrackedIssue) Reset()         { *m = TrackedIssue{} }
+func (m *TrackedIssue) String() string { return proto.CompactTextString(m) }
+func (*TrackedIssue) ProtoMessage()    {}
+func (*TrackedIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{28}
+}
+func (m *TrackedIssue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrackedIssue.Unmarshal(m, b)
+}
+func (m *TrackedIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrackedIssue.Marshal(b, m, deterministic)
+}
+func (dst *TrackedIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackedIssue.Merge(dst, src)
+}
+func (m *TrackedIssue) XXX_Size() int {
+	return xxx_messageInfo_TrackedIssue.Size(m)
+}
+func (m *TrackedIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackedIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackedIssue proto.InternalMessageInfo
+
+func (m *TrackedIssue) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *TrackedIssue) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *TrackedIssue) GetNumber() int32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *TrackedIssue) GetResolvedAt() int64 {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return 0
+}
+
+func (m *TrackedIssue) GetQuietPeriod() int64 {
+	if m != nil {
+		return m.QuietPeriod
+	}
+	return 0
+}
+
+type TrackedIssues struct {
+	Issues               []*TrackedIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TrackedIssues) Reset()         { *m = TrackedIssues{} }
+func (m *TrackedIssues) String() string { return proto.CompactTextString(m) }
+func (*TrackedIssues) ProtoMessage()    {}
+func (*TrackedIssues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{29}
+}
+func (m *TrackedIssues) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrackedIssues.Unmarshal(m, b)
+}
+func (m *TrackedIssues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrackedIssues.Marshal(b, m, deterministic)
+}
+func (dst *TrackedIssues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackedIssues.Merge(dst, src)
+}
+func (m *TrackedIssues) XXX_Size() int {
+	return xxx_messageInfo_TrackedIssues.Size(m)
+}
+func (m *TrackedIssues) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackedIssues.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackedIssues proto.InternalMessageInfo
+
+func (m *TrackedIssues) GetIssues() []*TrackedIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+type ResolveRequest struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// The repo the issue was filed in, fingerprints are only unique within one
+	Service string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	// Seconds the service must stay healthy before we close, defaults to an hour
+	QuietPeriod          int64    `protobuf:"varint,2,opt,name=quiet_period,json=quietPeriod,proto3" json:"quiet_period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveRequest) Reset()         { *m = ResolveRequest{} }
+func (m *ResolveRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveRequest) ProtoMessage()    {}
+func (*ResolveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{30}
+}
+func (m *ResolveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveRequest.Unmarshal(m, b)
+}
+func (m *ResolveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResolveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveRequest.Merge(dst, src)
+}
+func (m *ResolveRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveRequest.Size(m)
+}
+func (m *ResolveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveRequest proto.InternalMessageInfo
+
+func (m *ResolveRequest) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *ResolveRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *ResolveRequest) GetQuietPeriod() int64 {
+	if m != nil {
+		return m.QuietPeriod
+	}
+	return 0
+}
+
+type ResolveResponse struct {
+	Issue                *TrackedIssue `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ResolveResponse) Reset()         { *m = ResolveResponse{} }
+func (m *ResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveResponse) ProtoMessage()    {}
+func (*ResolveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{31}
+}
+func (m *ResolveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveResponse.Unmarshal(m, b)
+}
+func (m *ResolveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveResponse.Marshal(b, m, deterministic)
+}
+func (dst *ResolveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveResponse.Merge(dst, src)
+}
+func (m *ResolveResponse) XXX_Size() int {
+	return xxx_messageInfo_ResolveResponse.Size(m)
+}
+func (m *ResolveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveResponse proto.InternalMessageInfo
+
+func (m *ResolveResponse) GetIssue() *TrackedIssue {
+	if m != nil {
+		return m.Issue
+	}
+	return nil
+}
+
+type Template struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Go text/templates executed against the Issue
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body                 string   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Template) Reset()         { *m = Template{} }
+func (m *Template) String() string { return proto.CompactTextString(m) }
+func (*Template) ProtoMessage()    {}
+func (*Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{32}
+}
+func (m *Template) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Template.Unmarshal(m, b)
+}
+func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Template.Marshal(b, m, deterministic)
+}
+func (dst *Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Template.Merge(dst, src)
+}
+func (m *Template) XXX_Size() int {
+	return xxx_messageInfo_Template.Size(m)
+}
+func (m *Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_Template.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Template proto.InternalMessageInfo
+
+func (m *Template) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Template) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Template) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+type Route struct {
+	// Empty matches every service, the first matching route wins
+	Service  string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// Extra patterns to redact on top of the built in detectors
+	Redactions []string `protobuf:"bytes,3,rep,name=redactions,proto3" json:"redactions,omitempty"`
+	// Neutralise mentions and issue references in what the service sends,
+	// fencing any logs, while leaving the template's own formatting alone
+	Sanitise             bool     `protobuf:"varint,4,opt,name=sanitise,proto3" json:"sanitise,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{33}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Route.Unmarshal(m, b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+}
+func (dst *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(dst, src)
+}
+func (m *Route) XXX_Size() int {
+	return xxx_messageInfo_Route.Size(m)
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *Route) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *Route) GetRedactions() []string {
+	if m != nil {
+		return m.Redactions
+	}
+	return nil
+}
+
+func (m *Route) GetSanitise() bool {
+	if m != nil {
+		return m.Sanitise
+	}
+	return false
+}
+
+type FilingConfig struct {
+	Templates            []*Template `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Routes               []*Route    `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *FilingConfig) Reset()         { *m = FilingConfig{} }
+func (m *FilingConfig) String() string { return proto.CompactTextString(m) }
+func (*FilingConfig) ProtoMessage()    {}
+func (*FilingConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{34}
+}
+func (m *FilingConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilingConfig.Unmarshal(m, b)
+}
+func (m *FilingConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilingConfig.Marshal(b, m, deterministic)
+}
+func (dst *FilingConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilingConfig.Merge(dst, src)
+}
+func (m *FilingConfig) XXX_Size() int {
+	return xxx_messageInfo_FilingConfig.Size(m)
+}
+func (m *FilingConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilingConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilingConfig proto.InternalMessageInfo
+
+func (m *FilingConfig) GetTemplates() []*Template {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *FilingConfig) GetRoutes() []*Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	// The calling service, or the bridge's own task name
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Rpc    string `protobuf:"bytes,2,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	Repo   string `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Number int32  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	// ok, or the error we hit
+	Outcome   string `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// What was changed when it isn't an issue: a notification thread, gist or config key
+	Target               string   `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{35}
+}
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (dst *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(dst, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRpc() string {
+	if m != nil {
+		return m.Rpc
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetNumber() int32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type AuditLog struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditLog) Reset()         { *m = AuditLog{} }
+func (m *AuditLog) String() string { return proto.CompactTextString(m) }
+func (*AuditLog) ProtoMessage()    {}
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{36}
+}
+func (m *AuditLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLog.Unmarshal(m, b)
+}
+func (m *AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLog.Marshal(b, m, deterministic)
+}
+func (dst *AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog.Merge(dst, src)
+}
+func (m *AuditLog) XXX_Size() int {
+	return xxx_messageInfo_AuditLog.Size(m)
+}
+func (m *AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLog proto.InternalMessageInfo
+
+func (m *AuditLog) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type AuditRequest struct {
+	// Empty fields match everything
+	Caller               string   `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Rpc                  string   `protobuf:"bytes,2,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	Repo                 string   `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Since                int64    `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until                int64    `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+	FailuresOnly         bool     `protobuf:"varint,6,opt,name=failures_only,json=failuresOnly,proto3" json:"failures_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditRequest) Reset()         { *m = AuditRequest{} }
+func (m *AuditRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditRequest) ProtoMessage()    {}
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{37}
+}
+func (m *AuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditRequest.Unmarshal(m, b)
+}
+func (m *AuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditRequest.Marshal(b, m, deterministic)
+}
+func (dst *AuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRequest.Merge(dst, src)
+}
+func (m *AuditRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditRequest.Size(m)
+}
+func (m *AuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRequest proto.InternalMessageInfo
+
+func (m *AuditRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *AuditRequest) GetRpc() string {
+	if m != nil {
+		return m.Rpc
+	}
+	return ""
+}
+
+func (m *AuditRequest) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *AuditRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *AuditRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *AuditRequest) GetFailuresOnly() bool {
+	if m != nil {
+		return m.FailuresOnly
+	}
+	return false
+}
+
+type AuditResponse struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditResponse) Reset()         { *m = AuditResponse{} }
+func (m *AuditResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditResponse) ProtoMessage()    {}
+func (*AuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{38}
+}
+func (m *AuditResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditResponse.Unmarshal(m, b)
+}
+func (m *AuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditResponse.Marshal(b, m, deterministic)
+}
+func (dst *AuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditResponse.Merge(dst, src)
+}
+func (m *AuditResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditResponse.Size(m)
+}
+func (m *AuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditResponse proto.InternalMessageInfo
+
+func (m *AuditResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type Permission struct {
+	// Certificate common name under mTLS, callers without one are denied; * matches anyone
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// * allows every repo or rpc
+	Repos                []string `protobuf:"bytes,2,rep,name=repos,proto3" json:"repos,omitempty"`
+	Rpcs                 []string `protobuf:"bytes,3,rep,name=rpcs,proto3" json:"rpcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Permission) Reset()         { *m = Permission{} }
+func (m *Permission) String() string { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()    {}
+func (*Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{39}
+}
+func (m *Permission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Permission.Unmarshal(m, b)
+}
+func (m *Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Permission.Marshal(b, m, deterministic)
+}
+func (dst *Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permission.Merge(dst, src)
+}
+func (m *Permission) XXX_Size() int {
+	return xxx_messageInfo_Permission.Size(m)
+}
+func (m *Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permission proto.InternalMessageInfo
+
+func (m *Permission) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *Permission) GetRepos() []string {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *Permission) GetRpcs() []string {
+	if m != nil {
+		return m.Rpcs
+	}
+	return nil
+}
+
+type AuthPolicy struct {
+	// An empty policy lets everyone do everything except change the policy, which only the admin can do
+	Permissions          []*Permission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuthPolicy) Reset()         { *m = AuthPolicy{} }
+func (m *AuthPolicy) String() string { return proto.CompactTextString(m) }
+func (*AuthPolicy) ProtoMessage()    {}
+func (*AuthPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{40}
+}
+func (m *AuthPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthPolicy.Unmarshal(m, b)
+}
+func (m *AuthPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthPolicy.Marshal(b, m, deterministic)
+}
+func (dst *AuthPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthPolicy.Merge(dst, src)
+}
+func (m *AuthPolicy) XXX_Size() int {
+	return xxx_messageInfo_AuthPolicy.Size(m)
+}
+func (m *AuthPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthPolicy proto.InternalMessageInfo
+
+func (m *AuthPolicy) GetPermissions() []*Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type Quota struct {
+	// The calling service's certificate name or target repo, * sets the default;
+	// callers without a certificate are only held to the repo quota
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Issues allowed per minute once the burst is spent
+	PerMinute            float64  `protobuf:"fixed64,2,opt,name=per_minute,json=perMinute,proto3" json:"per_minute,omitempty"`
+	Burst                int32    `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quota) Reset()         { *m = Quota{} }
+func (m *Quota) String() string { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()    {}
+func (*Quota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{41}
+}
+func (m *Quota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quota.Unmarshal(m, b)
+}
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
+}
+func (dst *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(dst, src)
+}
+func (m *Quota) XXX_Size() int {
+	return xxx_messageInfo_Quota.Size(m)
+}
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quota proto.InternalMessageInfo
+
+func (m *Quota) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Quota) GetPerMinute() float64 {
+	if m != nil {
+		return m.PerMinute
+	}
+	return 0
+}
+
+func (m *Quota) GetBurst() int32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+type QuotaConfig struct {
+	// Empty lists leave filing unlimited
+	Services             []*Quota `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Repos                []*Quota `protobuf:"bytes,2,rep,name=repos,proto3" json:"repos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaConfig) Reset()         { *m = QuotaConfig{} }
+func (m *QuotaConfig) String() string { return proto.CompactTextString(m) }
+func (*QuotaConfig) ProtoMessage()    {}
+func (*QuotaConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{42}
+}
+func (m *QuotaConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaConfig.Unmarshal(m, b)
+}
+func (m *QuotaConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaConfig.Marshal(b, m, deterministic)
+}
+func (dst *QuotaConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaConfig.Merge(dst, src)
+}
+func (m *QuotaConfig) XXX_Size() int {
+	return xxx_messageInfo_QuotaConfig.Size(m)
+}
+func (m *QuotaConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaConfig proto.InternalMessageInfo
+
+func (m *QuotaConfig) GetServices() []*Quota {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+func (m *QuotaConfig) GetRepos() []*Quota {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+type QuotaFiler struct {
+	// The certificate name of the filing service and the repo it files into
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Issues turned away since the last report, and the title of the newest
+	Suppressed int32  `protobuf:"varint,3,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+	Latest     string `protobuf:"bytes,4,opt,name=latest,proto3" json:"latest,omitempty"`
+	// The last issue filed, suppressions are reported as a comment on it
+	LastFiled            int32    `protobuf:"varint,5,opt,name=last_filed,json=lastFiled,proto3" json:"last_filed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaFiler) Reset()         { *m = QuotaFiler{} }
+func (m *QuotaFiler) String() string { return proto.CompactTextString(m) }
+func (*QuotaFiler) ProtoMessage()    {}
+func (*QuotaFiler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{43}
+}
+func (m *QuotaFiler) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaFiler.Unmarshal(m, b)
+}
+func (m *QuotaFiler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaFiler.Marshal(b, m, deterministic)
+}
+func (dst *QuotaFiler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaFiler.Merge(dst, src)
+}
+func (m *QuotaFiler) XXX_Size() int {
+	return xxx_messageInfo_QuotaFiler.Size(m)
+}
+func (m *QuotaFiler) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaFiler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaFiler proto.InternalMessageInfo
+
+func (m *QuotaFiler) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *QuotaFiler) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *QuotaFiler) GetSuppressed() int32 {
+	if m != nil {
+		return m.Suppressed
+	}
+	return 0
+}
+
+func (m *QuotaFiler) GetLatest() string {
+	if m != nil {
+		return m.Latest
+	}
+	return ""
+}
+
+func (m *QuotaFiler) GetLastFiled() int32 {
+	if m != nil {
+		return m.LastFiled
+	}
+	return 0
+}
+
+type QuotaState struct {
+	Filers               []*QuotaFiler `protobuf:"bytes,1,rep,name=filers,proto3" json:"filers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QuotaState) Reset()         { *m = QuotaState{} }
+func (m *QuotaState) String() string { return proto.CompactTextString(m) }
+func (*QuotaState) ProtoMessage()    {}
+func (*QuotaState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_githubcard_ba5a2102da4a3085, []int{44}
+}
+func (m *QuotaState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaState.Unmarshal(m, b)
+}
+func (m *QuotaState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaState.Marshal(b, m, deterministic)
+}
+func (dst *QuotaState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaState.Merge(dst, src)
+}
+func (m *QuotaState) XXX_Size() int {
+	return xxx_messageInfo_QuotaState.Size(m)
+}
+func (m *QuotaState) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaState proto.InternalMessageInfo
+
+func (m *QuotaState) GetFilers() []*QuotaFiler {
+	if m != nil {
+		return m.Filers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Token)(nil), "githubcard.Token")
+	proto.RegisterType((*Empty)(nil), "githubcard.Empty")
+	proto.RegisterType((*Issue)(nil), "githubcard.Issue")
+	proto.RegisterMapType((map[string]string)(nil), "githubcard.Issue.MetadataEntry")
+	proto.RegisterType((*Attachment)(nil), "githubcard.Attachment")
+	proto.RegisterType((*AttachmentIndex)(nil), "githubcard.AttachmentIndex")
+	proto.RegisterMapType((map[string]string)(nil), "githubcard.AttachmentIndex.GistsEntry")
+	proto.RegisterType((*IssueList)(nil), "githubcard.IssueList")
+	proto.RegisterType((*IssueMirror)(nil), "githubcard.IssueMirror")
+	proto.RegisterMapType((map[string]int64)(nil), "githubcard.IssueMirror.SnoozedEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "githubcard.IssueMirror.StaleMarkedEntry")
+	proto.RegisterType((*WatchRequest)(nil), "githubcard.WatchRequest")
+	proto.RegisterType((*IssueEvent)(nil), "githubcard.IssueEvent")
+	proto.RegisterType((*WebhookDelivery)(nil), "githubcard.WebhookDelivery")
+	proto.RegisterType((*WebhookLog)(nil), "githubcard.WebhookLog")
+	proto.RegisterType((*ReplayRequest)(nil), "githubcard.ReplayRequest")
+	proto.RegisterType((*ReplayResponse)(nil), "githubcard.ReplayResponse")
+	proto.RegisterType((*ScoringRule)(nil), "githubcard.ScoringRule")
+	proto.RegisterType((*ScoringConfig)(nil), "githubcard.ScoringConfig")
+	proto.RegisterType((*ScoreComponent)(nil), "githubcard.ScoreComponent")
+	proto.RegisterType((*ScoreBreakdown)(nil), "githubcard.ScoreBreakdown")
+	proto.RegisterType((*ChannelRule)(nil), "githubcard.ChannelRule")
+	proto.RegisterType((*ChannelConfig)(nil), "githubcard.ChannelConfig")
+	proto.RegisterType((*BuildWatch)(nil), "githubcard.BuildWatch")
+	proto.RegisterType((*BuildConfig)(nil), "githubcard.BuildConfig")
+	proto.RegisterType((*NotificationState)(nil), "githubcard.NotificationState")
+	proto.RegisterType((*StalePolicy)(nil), "githubcard.StalePolicy")
+	proto.RegisterType((*StaleConfig)(nil), "githubcard.StaleConfig")
+	proto.RegisterType((*StaleAction)(nil), "githubcard.StaleAction")
+	proto.RegisterType((*StaleReport)(nil), "githubcard.StaleReport")
+	proto.RegisterType((*SLA)(nil), "githubcard.SLA")
+	proto.RegisterType((*SLAConfig)(nil), "githubcard.SLAConfig")
+	proto.RegisterType((*TrackedIssue)(nil), "githubcard.TrackedIssue")
+	proto.RegisterType((*TrackedIssues)(nil), "githubcard.TrackedIssues")
+	proto.RegisterType((*ResolveRequest)(nil), "githubcard.ResolveRequest")
+	proto.RegisterType((*ResolveResponse)(nil), "githubcard.ResolveResponse")
+	proto.RegisterType((*Template)(nil), "githubcard.Template")
+	proto.RegisterType((*Route)(nil), "githubcard.Route")
+	proto.RegisterType((*FilingConfig)(nil), "githubcard.FilingConfig")
+	proto.RegisterType((*AuditEvent)(nil), "githubcard.AuditEvent")
+	proto.RegisterType((*AuditLog)(nil), "githubcard.AuditLog")
+	proto.RegisterType((*AuditRequest)(nil), "githubcard.AuditRequest")
+	proto.RegisterType((*AuditResponse)(nil), "githubcard.AuditResponse")
+	proto.RegisterType((*Permission)(nil), "githubcard.Permission")
+	proto.RegisterType((*AuthPolicy)(nil), "githubcard.AuthPolicy")
+	proto.RegisterType((*Quota)(nil), "githubcard.Quota")
+	proto.RegisterType((*QuotaConfig)(nil), "githubcard.QuotaConfig")
+	proto.RegisterType((*QuotaFiler)(nil), "githubcard.QuotaFiler")
+	proto.RegisterType((*QuotaState)(nil), "githubcard.QuotaState")
+	proto.RegisterEnum("githubcard.Issue_IssueState", Issue_IssueState_name, Issue_IssueState_value)
+	proto.RegisterEnum("githubcard.Issue_PullRequestState", Issue_PullRequestState_name, Issue_PullRequestState_value)
+	proto.RegisterEnum("githubcard.IssueEvent_EventType", IssueEvent_EventType_name, IssueEvent_EventType_value)
+	proto.RegisterEnum("githubcard.ScoringRule_Factor", ScoringRule_Factor_name, ScoringRule_Factor_value)
+	proto.RegisterEnum("githubcard.StaleAction_Action", StaleAction_Action_name, StaleAction_Action_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GithubClient is the client API for Github service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GithubClient interface {
+	AddIssue(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error)
+	Get(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error)
+	WatchIssues(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Github_WatchIssuesClient, error)
+	Replay(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (*ReplayResponse, error)
+	GetScoring(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScoringConfig, error)
+	SetScoring(ctx context.Context, in *ScoringConfig, opts ...grpc.CallOption) (*ScoringConfig, error)
+	ExplainScore(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*ScoreBreakdown, error)
+	GetChannels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChannelConfig, error)
+	SetChannels(ctx context.Context, in *ChannelConfig, opts ...grpc.CallOption) (*ChannelConfig, error)
+	GetBuilds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BuildConfig, error)
+	SetBuilds(ctx context.Context, in *BuildConfig, opts ...grpc.CallOption) (*BuildConfig, error)
+	GetStalePolicies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StaleConfig, error)
+	SetStalePolicies(ctx context.Context, in *StaleConfig, opts ...grpc.CallOption) (*StaleConfig, error)
+	GetStaleReport(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StaleReport, error)
+	GetSLAs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SLAConfig, error)
+	SetSLAs(ctx context.Context, in *SLAConfig, opts ...grpc.CallOption) (*SLAConfig, error)
+	ResolveIssue(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error)
+	GetFiling(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FilingConfig, error)
+	SetFiling(ctx context.Context, in *FilingConfig, opts ...grpc.CallOption) (*FilingConfig, error)
+	ListAuditEvents(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*AuditResponse, error)
+	GetAuthPolicy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AuthPolicy, error)
+	SetAuthPolicy(ctx context.Context, in *AuthPolicy, opts ...grpc.CallOption) (*AuthPolicy, error)
+	GetQuotas(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*QuotaConfig, error)
+	SetQuotas(ctx context.Context, in *QuotaConfig, opts ...grpc.CallOption) (*QuotaConfig, error)
+}
+
+type githubClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGithubClient(cc *grpc.ClientConn) GithubClient {
+	return &githubClient{cc}
+}
+
+func (c *githubClient) AddIssue(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error) {
+	out := new(Issue)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/AddIssue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) Get(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*Issue, error) {
+	out := new(Issue)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) WatchIssues(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Github_WatchIssuesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Github_serviceDesc.Streams[0], "/githubcard.Github/WatchIssues", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &githubWatchIssuesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Github_WatchIssuesClient interface {
+	Recv() (*IssueEvent, error)
+	grpc.ClientStream
+}
+
+type githubWatchIssuesClient struct {
+	grpc.ClientStream
+}
+
+func (x *githubWatchIssuesClient) Recv() (*IssueEvent, error) {
+	m := new(IssueEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *githubClient) Replay(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (*ReplayResponse, error) {
+	out := new(ReplayResponse)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/Replay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetScoring(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScoringConfig, error) {
+	out := new(ScoringConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetScoring", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetScoring(ctx context.Context, in *ScoringConfig, opts ...grpc.CallOption) (*ScoringConfig, error) {
+	out := new(ScoringConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetScoring", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) ExplainScore(ctx context.Context, in *Issue, opts ...grpc.CallOption) (*ScoreBreakdown, error) {
+	out := new(ScoreBreakdown)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/ExplainScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetChannels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChannelConfig, error) {
+	out := new(ChannelConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetChannels(ctx context.Context, in *ChannelConfig, opts ...grpc.CallOption) (*ChannelConfig, error) {
+	out := new(ChannelConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetBuilds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BuildConfig, error) {
+	out := new(BuildConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetBuilds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetBuilds(ctx context.Context, in *BuildConfig, opts ...grpc.CallOption) (*BuildConfig, error) {
+	out := new(BuildConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetBuilds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetStalePolicies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StaleConfig, error) {
+	out := new(StaleConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetStalePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetStalePolicies(ctx context.Context, in *StaleConfig, opts ...grpc.CallOption) (*StaleConfig, error) {
+	out := new(StaleConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetStalePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetStaleReport(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StaleReport, error) {
+	out := new(StaleReport)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetStaleReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetSLAs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SLAConfig, error) {
+	out := new(SLAConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetSLAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetSLAs(ctx context.Context, in *SLAConfig, opts ...grpc.CallOption) (*SLAConfig, error) {
+	out := new(SLAConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetSLAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) ResolveIssue(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error) {
+	out := new(ResolveResponse)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/ResolveIssue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetFiling(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FilingConfig, error) {
+	out := new(FilingConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetFiling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetFiling(ctx context.Context, in *FilingConfig, opts ...grpc.CallOption) (*FilingConfig, error) {
+	out := new(FilingConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetFiling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) ListAuditEvents(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*AuditResponse, error) {
+	out := new(AuditResponse)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetAuthPolicy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AuthPolicy, error) {
+	out := new(AuthPolicy)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetAuthPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetAuthPolicy(ctx context.Context, in *AuthPolicy, opts ...grpc.CallOption) (*AuthPolicy, error) {
+	out := new(AuthPolicy)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetAuthPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) GetQuotas(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*QuotaConfig, error) {
+	out := new(QuotaConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/GetQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubClient) SetQuotas(ctx context.Context, in *QuotaConfig, opts ...grpc.CallOption) (*QuotaConfig, error) {
+	out := new(QuotaConfig)
+	err := c.cc.Invoke(ctx, "/githubcard.Github/SetQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GithubServer is the server API for Github service.
+type GithubServer interface {
+	AddIssue(context.Context, *Issue) (*Issue, error)
+	Get(context.Context, *Issue) (*Issue, error)
+	WatchIssues(*WatchRequest, Github_WatchIssuesServer) error
+	Replay(context.Context, *ReplayRequest) (*ReplayResponse, error)
+	GetScoring(context.Context, *Empty) (*ScoringConfig, error)
+	SetScoring(context.Context, *ScoringConfig) (*ScoringConfig, error)
+	ExplainScore(context.Context, *Issue) (*ScoreBreakdown, error)
+	GetChannels(context.Context, *Empty) (*ChannelConfig, error)
+	SetChannels(context.Context, *ChannelConfig) (*ChannelConfig, error)
+	GetBuilds(context.Context, *Empty) (*BuildConfig, error)
+	SetBuilds(context.Context, *BuildConfig) (*BuildConfig, error)
+	GetStalePolicies(context.Context, *Empty) (*StaleConfig, error)
+	SetStalePolicies(context.Context, *StaleConfig) (*StaleConfig, error)
+	GetStaleReport(context.Context, *Empty) (*StaleReport, error)
+	GetSLAs(context.Context, *Empty) (*SLAConfig, error)
+	SetSLAs(context.Context, *SLAConfig) (*SLAConfig, error)
+	ResolveIssue(context.Context, *ResolveRequest) (*ResolveResponse, error)
+	GetFiling(context.Context, *Empty) (*FilingConfig, error)
+	SetFiling(context.Context, *FilingConfig) (*FilingConfig, error)
+	ListAuditEvents(context.Context, *AuditRequest) (*AuditResponse, error)
+	GetAuthPolicy(context.Context, *Empty) (*AuthPolicy, error)
+	SetAuthPolicy(context.Context, *AuthPolicy) (*AuthPolicy, error)
+	GetQuotas(context.Context, *Empty) (*QuotaConfig, error)
+	SetQuotas(context.Context, *QuotaConfig) (*QuotaConfig, error)
+}
+
+func RegisterGithubServer(s *grpc.Server, srv GithubServer) {
+	s.RegisterService(&_Github_serviceDesc, srv)
 }
 
 func _Github_AddIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -265,32 +2987,431 @@ func _Github_AddIssue_Handler(srv interface{}, ctx context.Context, dec func(int
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GithubServer).AddIssue(ctx, in)
+		return srv.(GithubServer).AddIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/AddIssue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).AddIssue(ctx, req.(*Issue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Issue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).Get(ctx, req.(*Issue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_WatchIssues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GithubServer).WatchIssues(m, &githubWatchIssuesServer{stream})
+}
+
+type Github_WatchIssuesServer interface {
+	Send(*IssueEvent) error
+	grpc.ServerStream
+}
+
+type githubWatchIssuesServer struct {
+	grpc.ServerStream
+}
+
+func (x *githubWatchIssuesServer) Send(m *IssueEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Github_Replay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).Replay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/Replay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).Replay(ctx, req.(*ReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetScoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetScoring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetScoring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetScoring(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_SetScoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoringConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).SetScoring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/SetScoring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).SetScoring(ctx, req.(*ScoringConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_ExplainScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Issue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).ExplainScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/ExplainScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).ExplainScore(ctx, req.(*Issue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/githubcard.Github/AddIssue",
+		FullMethod: "/githubcard.Github/GetChannels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GithubServer).AddIssue(ctx, req.(*Issue))
+		return srv.(GithubServer).GetChannels(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Github_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Issue)
+func _Github_SetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelConfig)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GithubServer).Get(ctx, in)
+		return srv.(GithubServer).SetChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/githubcard.Github/Get",
+		FullMethod: "/githubcard.Github/SetChannels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GithubServer).Get(ctx, req.(*Issue))
+		return srv.(GithubServer).SetChannels(ctx, req.(*ChannelConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetBuilds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetBuilds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetBuilds(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_SetBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).SetBuilds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/SetBuilds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).SetBuilds(ctx, req.(*BuildConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetStalePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetStalePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetStalePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetStalePolicies(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_SetStalePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaleConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).SetStalePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/SetStalePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).SetStalePolicies(ctx, req.(*StaleConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetStaleReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetStaleReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetStaleReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetStaleReport(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetSLAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetSLAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetSLAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetSLAs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_SetSLAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLAConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).SetSLAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/SetSLAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).SetSLAs(ctx, req.(*SLAConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_ResolveIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).ResolveIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/ResolveIssue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).ResolveIssue(ctx, req.(*ResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetFiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetFiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetFiling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetFiling(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_SetFiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilingConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).SetFiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/SetFiling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).SetFiling(ctx, req.(*FilingConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).ListAuditEvents(ctx, req.(*AuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetAuthPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetAuthPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetAuthPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetAuthPolicy(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_SetAuthPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).SetAuthPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/SetAuthPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).SetAuthPolicy(ctx, req.(*AuthPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_GetQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).GetQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/GetQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).GetQuotas(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Github_SetQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubServer).SetQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/githubcard.Github/SetQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubServer).SetQuotas(ctx, req.(*QuotaConfig))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -307,31 +3428,263 @@ var _Github_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Github_Get_Handler,
 		},
+		{
+			MethodName: "Replay",
+			Handler:    _Github_Replay_Handler,
+		},
+		{
+			MethodName: "GetScoring",
+			Handler:    _Github_GetScoring_Handler,
+		},
+		{
+			MethodName: "SetScoring",
+			Handler:    _Github_SetScoring_Handler,
+		},
+		{
+			MethodName: "ExplainScore",
+			Handler:    _Github_ExplainScore_Handler,
+		},
+		{
+			MethodName: "GetChannels",
+			Handler:    _Github_GetChannels_Handler,
+		},
+		{
+			MethodName: "SetChannels",
+			Handler:    _Github_SetChannels_Handler,
+		},
+		{
+			MethodName: "GetBuilds",
+			Handler:    _Github_GetBuilds_Handler,
+		},
+		{
+			MethodName: "SetBuilds",
+			Handler:    _Github_SetBuilds_Handler,
+		},
+		{
+			MethodName: "GetStalePolicies",
+			Handler:    _Github_GetStalePolicies_Handler,
+		},
+		{
+			MethodName: "SetStalePolicies",
+			Handler:    _Github_SetStalePolicies_Handler,
+		},
+		{
+			MethodName: "GetStaleReport",
+			Handler:    _Github_GetStaleReport_Handler,
+		},
+		{
+			MethodName: "GetSLAs",
+			Handler:    _Github_GetSLAs_Handler,
+		},
+		{
+			MethodName: "SetSLAs",
+			Handler:    _Github_SetSLAs_Handler,
+		},
+		{
+			MethodName: "ResolveIssue",
+			Handler:    _Github_ResolveIssue_Handler,
+		},
+		{
+			MethodName: "GetFiling",
+			Handler:    _Github_GetFiling_Handler,
+		},
+		{
+			MethodName: "SetFiling",
+			Handler:    _Github_SetFiling_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Github_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetAuthPolicy",
+			Handler:    _Github_GetAuthPolicy_Handler,
+		},
+		{
+			MethodName: "SetAuthPolicy",
+			Handler:    _Github_SetAuthPolicy_Handler,
+		},
+		{
+			MethodName: "GetQuotas",
+			Handler:    _Github_GetQuotas_Handler,
+		},
+		{
+			MethodName: "SetQuotas",
+			Handler:    _Github_SetQuotas_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchIssues",
+			Handler:       _Github_WatchIssues_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "githubcard.proto",
 }
 
-func init() { proto.RegisterFile("githubcard.proto", fileDescriptor_githubcard_80558b1df39b95f8) }
-
-var fileDescriptor_githubcard_80558b1df39b95f8 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xd1, 0x4a, 0xf3, 0x30,
-	0x14, 0xc7, 0x97, 0xaf, 0x4d, 0xbe, 0xee, 0x08, 0x52, 0x0f, 0x22, 0x41, 0x14, 0x4a, 0xae, 0xea,
-	0x85, 0xbb, 0x88, 0xe0, 0xbd, 0xe8, 0x18, 0xc2, 0x70, 0x92, 0xf9, 0x02, 0x6b, 0x1b, 0x34, 0x54,
-	0x57, 0x69, 0x52, 0x61, 0xcf, 0xe9, 0x0b, 0x49, 0xd2, 0xea, 0x84, 0xde, 0x78, 0x13, 0xce, 0xef,
-	0x7f, 0x7e, 0xc9, 0x09, 0x09, 0xa4, 0xcf, 0xc6, 0xbd, 0x74, 0x45, 0xb9, 0x69, 0xab, 0xd9, 0x7b,
-	0xdb, 0xb8, 0x06, 0x61, 0x9f, 0x88, 0x73, 0xa0, 0x4f, 0x4d, 0xad, 0xb7, 0x78, 0x0c, 0xd4, 0xf9,
-	0x82, 0x93, 0x8c, 0xe4, 0x53, 0xd5, 0x83, 0xf8, 0x24, 0x40, 0xef, 0xad, 0xed, 0x74, 0xe8, 0x1b,
-	0xf7, 0xaa, 0x7f, 0xfa, 0x1e, 0x10, 0x21, 0x2e, 0x9a, 0x6a, 0xc7, 0xff, 0x85, 0x30, 0xd4, 0xc8,
-	0xe1, 0xbf, 0xd5, 0xed, 0x87, 0x29, 0x35, 0x8f, 0x42, 0xfc, 0x8d, 0x78, 0x02, 0x6c, 0xdb, 0xbd,
-	0x15, 0xba, 0xe5, 0x71, 0x46, 0x72, 0xaa, 0x06, 0x42, 0x09, 0xd4, 0xba, 0x8d, 0xd3, 0x9c, 0x66,
-	0x24, 0x3f, 0x94, 0x67, 0xb3, 0x5f, 0x57, 0x0e, 0xd3, 0xfb, 0x75, 0xed, 0x1d, 0xd5, 0xab, 0xfe,
-	0x2c, 0xeb, 0x4c, 0x59, 0xef, 0x38, 0xcb, 0x48, 0x9e, 0xa8, 0x81, 0x84, 0x00, 0xd8, 0xcb, 0x98,
-	0x40, 0xbc, 0x7a, 0x9c, 0x3f, 0xa4, 0x13, 0x04, 0x60, 0xb7, 0xcb, 0xd5, 0x7a, 0x7e, 0x97, 0x12,
-	0x71, 0x0d, 0xd3, 0xe0, 0x2c, 0x8d, 0x75, 0x78, 0x01, 0xcc, 0x78, 0xb0, 0x9c, 0x64, 0x51, 0x7e,
-	0x20, 0x8f, 0x46, 0xd3, 0xd5, 0x20, 0xc8, 0x1a, 0xd8, 0x22, 0xf4, 0x50, 0x42, 0x72, 0x53, 0x55,
-	0xfd, 0xcb, 0x8c, 0x37, 0x9c, 0x8e, 0x23, 0x31, 0xc1, 0x4b, 0x88, 0x16, 0xda, 0xfd, 0x55, 0x2f,
-	0x58, 0xf8, 0xac, 0xab, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xbe, 0x33, 0x41, 0xc0, 0x01,
-	0x00, 0x00,
+func init() { proto.RegisterFile("githubcard.proto", fileDescriptor_githubcard_ba5a2102da4a3085) }
+
+var fileDescriptor_githubcard_ba5a2102da4a3085 = []byte{
+	// 2554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4d, 0x73, 0x1b, 0xc7,
+	0xd1, 0xc6, 0xe2, 0x1b, 0x0d, 0x80, 0x84, 0xe7, 0x95, 0xe5, 0x35, 0x6c, 0xd9, 0x7c, 0x47, 0x71,
+	0x42, 0x57, 0xd9, 0x2c, 0x47, 0x4e, 0x5c, 0xb2, 0xe4, 0xc8, 0x86, 0xc8, 0x25, 0xcc, 0x32, 0x08,
+	0xd2, 0x03, 0x3a, 0x3a, 0xa5, 0x50, 0xcb, 0xc5, 0x90, 0xdc, 0xe2, 0x62, 0x77, 0xbd, 0x3b, 0xa0,
+	0x8d, 0x5c, 0x73, 0x48, 0xe5, 0x90, 0xca, 0x39, 0x87, 0x9c, 0x52, 0xc9, 0x3d, 0xb9, 0xe4, 0xb7,
+	0xe4, 0x92, 0xdf, 0x92, 0x9a, 0x9e, 0xd9, 0x0f, 0x80, 0x0b, 0x4a, 0xaa, 0x5c, 0x24, 0x74, 0xcf,
+	0x33, 0x3d, 0xdd, 0xd3, 0xdd, 0xd3, 0xdd, 0x4b, 0xe8, 0x5d, 0xba, 0xe2, 0x6a, 0x71, 0xee, 0xd8,
+	0xd1, 0x6c, 0x2f, 0x8c, 0x02, 0x11, 0x10, 0xc8, 0x38, 0xf4, 0x01, 0xd4, 0xce, 0x82, 0x6b, 0xee,
+	0x93, 0x7b, 0x50, 0x13, 0xf2, 0x87, 0x69, 0xec, 0x18, 0xbb, 0x2d, 0xa6, 0x08, 0xda, 0x80, 0x9a,
+	0x35, 0x0f, 0xc5, 0x92, 0xfe, 0xb5, 0x01, 0xb5, 0xa3, 0x38, 0x5e, 0x70, 0x04, 0xba, 0xc2, 0xe3,
+	0x29, 0x50, 0x12, 0x84, 0x40, 0xf5, 0x3c, 0x98, 0x2d, 0xcd, 0x32, 0x32, 0xf1, 0x37, 0x31, 0xa1,
+	0x11, 0xf3, 0xe8, 0xc6, 0x75, 0xb8, 0x59, 0x41, 0x76, 0x42, 0x92, 0xfb, 0x50, 0xf7, 0x17, 0xf3,
+	0x73, 0x1e, 0x99, 0xd5, 0x1d, 0x63, 0xb7, 0xc6, 0x34, 0x45, 0x1e, 0x41, 0x2d, 0x16, 0xb6, 0xe0,
+	0x66, 0x6d, 0xc7, 0xd8, 0xdd, 0x7a, 0xf4, 0xee, 0x5e, 0x4e, 0x77, 0x3c, 0x5d, 0xfd, 0x3b, 0x91,
+	0x18, 0xa6, 0xa0, 0x52, 0x56, 0x2c, 0x5c, 0xe7, 0x7a, 0x69, 0xd6, 0x77, 0x8c, 0xdd, 0x26, 0xd3,
+	0x94, 0xe4, 0x7b, 0xf6, 0x39, 0xf7, 0x62, 0xb3, 0xb1, 0x53, 0xd9, 0x6d, 0x31, 0x4d, 0x91, 0x3e,
+	0x34, 0x9d, 0x60, 0x3e, 0xe7, 0xbe, 0x88, 0xcd, 0x26, 0x9e, 0x9e, 0xd2, 0xa4, 0x07, 0x95, 0x45,
+	0xe4, 0x99, 0x2d, 0xd4, 0x56, 0xfe, 0x24, 0x0f, 0x00, 0x9c, 0x88, 0xdb, 0x82, 0xcf, 0xa6, 0xb6,
+	0x30, 0x61, 0xc7, 0xd8, 0xad, 0xb0, 0x96, 0xe6, 0x0c, 0x84, 0x5c, 0x5e, 0x84, 0xb3, 0x64, 0xb9,
+	0xad, 0x96, 0x35, 0x67, 0x20, 0xe4, 0x59, 0x76, 0x1c, 0xbb, 0x97, 0x3e, 0xe7, 0x66, 0x07, 0x85,
+	0xa6, 0x34, 0x79, 0x17, 0x5a, 0x11, 0xb7, 0x1d, 0xe1, 0x06, 0x7e, 0x6c, 0x76, 0x51, 0x91, 0x8c,
+	0x41, 0xfe, 0x1f, 0x3a, 0xe1, 0xc2, 0xf3, 0xa6, 0x11, 0xff, 0x7e, 0xc1, 0x63, 0x61, 0x6e, 0xa1,
+	0x6d, 0x6d, 0xc9, 0x63, 0x8a, 0x45, 0x4e, 0x81, 0xe4, 0x21, 0x53, 0x75, 0x73, 0xdb, 0x78, 0x73,
+	0xf4, 0xf6, 0xcd, 0x9d, 0x66, 0x5b, 0xd5, 0xfd, 0xf5, 0xc2, 0x35, 0x0e, 0xd9, 0x81, 0xf6, 0x85,
+	0xeb, 0x5f, 0xf2, 0x28, 0x8c, 0x5c, 0x5f, 0x98, 0x3d, 0xd4, 0x38, 0xcf, 0x92, 0x6e, 0xbe, 0x0a,
+	0x62, 0x61, 0xbe, 0xa1, 0xdc, 0x2c, 0x7f, 0x4b, 0x37, 0xdf, 0xf0, 0x28, 0x76, 0x03, 0xdf, 0x24,
+	0xca, 0xcd, 0x9a, 0x94, 0xa1, 0xc2, 0xa3, 0x28, 0x88, 0xcc, 0xff, 0x53, 0xa1, 0x82, 0x84, 0xe4,
+	0xc6, 0xc2, 0x76, 0xae, 0xcd, 0x7b, 0x8a, 0x8b, 0x04, 0x79, 0x0a, 0xcd, 0x39, 0x17, 0xf6, 0xcc,
+	0x16, 0xb6, 0xf9, 0xe6, 0x4e, 0x65, 0xb7, 0xfd, 0xe8, 0xfd, 0xdb, 0x36, 0x1c, 0x6b, 0x84, 0xe5,
+	0x8b, 0x68, 0xc9, 0xd2, 0x0d, 0xe4, 0x31, 0xb4, 0x6d, 0x21, 0x6c, 0xe7, 0x4a, 0xb9, 0xf5, 0x3e,
+	0xee, 0xbf, 0x9f, 0xdf, 0x3f, 0x48, 0x97, 0x59, 0x1e, 0x2a, 0xa3, 0xc4, 0xb1, 0x3d, 0x8f, 0x47,
+	0xe6, 0x5b, 0xa8, 0x8d, 0xa6, 0xfa, 0x4f, 0xa1, 0xbb, 0x72, 0x98, 0x0c, 0x8d, 0x6b, 0xbe, 0xd4,
+	0x41, 0x2f, 0x7f, 0x4a, 0x3b, 0x6e, 0x6c, 0x6f, 0xc1, 0x75, 0xcc, 0x2b, 0xe2, 0x49, 0xf9, 0xb1,
+	0x41, 0x29, 0x40, 0x16, 0xa7, 0xa4, 0x09, 0xd5, 0x93, 0x53, 0x6b, 0xdc, 0x2b, 0x11, 0x80, 0xfa,
+	0xfe, 0xe8, 0x64, 0x62, 0x1d, 0xf4, 0x0c, 0x3a, 0x81, 0xde, 0xba, 0x47, 0x24, 0x72, 0x7c, 0x32,
+	0xb6, 0x7a, 0x25, 0x72, 0x0f, 0x7a, 0xcc, 0xfa, 0xf5, 0x91, 0xf5, 0x62, 0xca, 0xac, 0x6f, 0xbf,
+	0xb3, 0x26, 0x67, 0x72, 0x0f, 0x69, 0x43, 0xe3, 0x70, 0x70, 0x34, 0x3a, 0x1a, 0x0f, 0x7b, 0x65,
+	0x42, 0x60, 0x6b, 0xf0, 0x62, 0x70, 0x74, 0x76, 0x34, 0x1e, 0x4e, 0x8f, 0x2d, 0x36, 0xb4, 0x7a,
+	0x15, 0xfa, 0x1b, 0x80, 0xcc, 0x50, 0xe9, 0x2c, 0xdf, 0x9e, 0x27, 0x89, 0x8a, 0xbf, 0xa5, 0xb3,
+	0x9c, 0xc0, 0x17, 0xdc, 0x17, 0xa8, 0x76, 0x87, 0x25, 0xa4, 0x8c, 0x38, 0xfd, 0x73, 0x2a, 0x96,
+	0x61, 0x92, 0xb2, 0x6d, 0xcd, 0x3b, 0x5b, 0x86, 0x9c, 0xfe, 0xc1, 0x80, 0xed, 0x4c, 0xfe, 0x91,
+	0x3f, 0xe3, 0x3f, 0x92, 0x2f, 0xa0, 0x76, 0xe9, 0xc6, 0x22, 0x36, 0x0d, 0xbc, 0xf4, 0x9f, 0x16,
+	0x5f, 0x3a, 0x62, 0xf7, 0x86, 0x12, 0xa8, 0x7c, 0xa7, 0x36, 0xf5, 0x1f, 0x03, 0x64, 0xcc, 0xd7,
+	0xba, 0xe3, 0xcf, 0xa0, 0x85, 0x77, 0x3c, 0x72, 0x63, 0x41, 0x3e, 0x84, 0xba, 0x2b, 0x89, 0x44,
+	0x8b, 0x37, 0x6e, 0x85, 0x0e, 0xd3, 0x00, 0xfa, 0x9f, 0x32, 0xb4, 0x91, 0x73, 0xec, 0x62, 0x34,
+	0xbe, 0xfa, 0x56, 0xf2, 0x0e, 0xb4, 0x3c, 0x5b, 0x26, 0xda, 0xd2, 0x77, 0x50, 0xa1, 0x0a, 0x6b,
+	0x4a, 0xc6, 0x64, 0xe9, 0x3b, 0xe4, 0x19, 0x34, 0x62, 0x3f, 0x08, 0x7e, 0xcb, 0x67, 0x66, 0x05,
+	0x05, 0xfd, 0xe4, 0x96, 0x20, 0x75, 0xe2, 0xde, 0x44, 0xc1, 0xd4, 0x3d, 0x24, 0x9b, 0xc8, 0x37,
+	0xd0, 0x89, 0x85, 0xed, 0xf1, 0xe9, 0xdc, 0x8e, 0xae, 0xf9, 0xcc, 0xac, 0xa2, 0x90, 0xdd, 0x8d,
+	0x42, 0x24, 0xf6, 0x18, 0xa1, 0x4a, 0x50, 0x3b, 0xce, 0x38, 0xfd, 0x27, 0xd0, 0xc9, 0x9f, 0xf2,
+	0xb2, 0x8b, 0xad, 0xe4, 0x2e, 0xb6, 0xff, 0x0c, 0x7a, 0xeb, 0xc2, 0x5f, 0x67, 0x3f, 0xfd, 0x1c,
+	0x3a, 0x2f, 0x6c, 0xe1, 0x5c, 0x25, 0xcf, 0xd4, 0x6b, 0xf8, 0xe6, 0xdf, 0x86, 0x4e, 0x1c, 0xeb,
+	0x46, 0x46, 0xe4, 0x2f, 0xa0, 0x8a, 0x91, 0x68, 0xe0, 0x93, 0xb6, 0x73, 0x6b, 0x1f, 0xa2, 0xf6,
+	0xac, 0x1b, 0x1d, 0x9e, 0x0c, 0xd1, 0xe4, 0x67, 0x50, 0x43, 0x71, 0xa8, 0x59, 0xe1, 0x71, 0x6a,
+	0x5d, 0x3e, 0xc0, 0xc2, 0x9d, 0xf3, 0x58, 0xd8, 0xf3, 0x10, 0xa3, 0xbd, 0xc2, 0x32, 0x06, 0x1d,
+	0x43, 0x2b, 0x95, 0x2c, 0x13, 0x57, 0xa6, 0xb0, 0x75, 0xd0, 0x2b, 0x91, 0x2e, 0xb4, 0xf6, 0x4f,
+	0x8e, 0x8f, 0xad, 0xb1, 0xca, 0xc9, 0x0e, 0x34, 0x47, 0x83, 0xe7, 0xd6, 0x68, 0x64, 0x1d, 0xf4,
+	0xca, 0xb9, 0x0c, 0xaf, 0xc8, 0x15, 0x66, 0xe9, 0x6d, 0x55, 0xfa, 0x47, 0x03, 0xb6, 0x5f, 0xf0,
+	0xf3, 0xab, 0x20, 0xb8, 0x3e, 0xe0, 0x9e, 0x7b, 0xc3, 0xa3, 0x25, 0xd9, 0x82, 0xb2, 0x3b, 0xd3,
+	0xb7, 0x5a, 0x76, 0x67, 0xf8, 0x5e, 0xde, 0x24, 0xa9, 0xd9, 0x62, 0x8a, 0x90, 0x29, 0x1b, 0xda,
+	0x4b, 0x2f, 0xb0, 0x67, 0xa8, 0x65, 0x87, 0x25, 0xe4, 0xaa, 0x05, 0xd5, 0x35, 0x0b, 0xe4, 0x6a,
+	0x18, 0x05, 0x0e, 0x8f, 0x63, 0x3e, 0xc3, 0x82, 0xda, 0x64, 0x19, 0x83, 0x1e, 0x01, 0x68, 0x75,
+	0x46, 0xc1, 0x25, 0x79, 0x0a, 0x30, 0x53, 0x5a, 0xb9, 0xa9, 0xa3, 0xde, 0xc9, 0xdf, 0xdc, 0x9a,
+	0xea, 0x2c, 0x07, 0xa7, 0x1f, 0x40, 0x97, 0xf1, 0xd0, 0xb3, 0x97, 0x89, 0xcb, 0xe5, 0x0b, 0xef,
+	0xfa, 0x8e, 0xf2, 0x5c, 0x85, 0x29, 0x82, 0x7e, 0x04, 0x5b, 0x09, 0x2c, 0x0e, 0x03, 0x3f, 0xe6,
+	0xb2, 0x3c, 0x46, 0xc8, 0xe1, 0xea, 0x16, 0x6a, 0x2c, 0xa5, 0xe9, 0xef, 0xca, 0xd0, 0x9e, 0x38,
+	0x41, 0xe4, 0xfa, 0x97, 0x6c, 0xe1, 0x71, 0xf2, 0x19, 0xd4, 0x2f, 0x6c, 0x47, 0x04, 0x91, 0x0e,
+	0x87, 0xf7, 0xf2, 0xda, 0xe5, 0x80, 0x7b, 0x87, 0x88, 0x62, 0x1a, 0x2d, 0x75, 0x99, 0xcb, 0x70,
+	0x4c, 0xee, 0x14, 0x09, 0xf9, 0xec, 0xff, 0xc0, 0xdd, 0xcb, 0x2b, 0x81, 0x57, 0x5a, 0x63, 0x9a,
+	0x22, 0x0f, 0xa1, 0x9b, 0xaf, 0xa9, 0x31, 0xde, 0x6a, 0x93, 0x75, 0x72, 0xa5, 0x32, 0xa6, 0x2e,
+	0xd4, 0xd5, 0x21, 0xa4, 0x01, 0x95, 0xc1, 0x50, 0xbe, 0xd7, 0x2d, 0xa8, 0x61, 0x14, 0xa8, 0x80,
+	0xd0, 0xf1, 0x31, 0xe9, 0x95, 0x65, 0xb4, 0x30, 0x6b, 0xb0, 0x7f, 0x76, 0x74, 0x32, 0x9e, 0xa8,
+	0x98, 0x18, 0x4c, 0x26, 0x47, 0xc3, 0xb1, 0x65, 0xf5, 0xaa, 0xf2, 0xbd, 0x67, 0xd6, 0xe9, 0x49,
+	0xaf, 0x46, 0xee, 0x03, 0x39, 0xfd, 0x6e, 0x34, 0x4a, 0x5e, 0xfb, 0xe9, 0xe4, 0x6c, 0x70, 0x66,
+	0xf5, 0xea, 0xf4, 0x19, 0x74, 0xb5, 0x6d, 0xfb, 0x81, 0x7f, 0xe1, 0x5e, 0x92, 0x8f, 0xa1, 0x16,
+	0x2d, 0xbc, 0xd4, 0x47, 0x6f, 0x6d, 0xb8, 0x05, 0xa6, 0x50, 0xf4, 0x09, 0x6c, 0x49, 0x2e, 0xdf,
+	0x0f, 0xe6, 0x61, 0xe0, 0xeb, 0xa2, 0x20, 0x97, 0x92, 0xa2, 0x20, 0x7f, 0xa3, 0xbf, 0x24, 0x0a,
+	0xef, 0xa8, 0xc6, 0x14, 0x41, 0xcf, 0xf5, 0xde, 0xe7, 0x11, 0xb7, 0xaf, 0x67, 0xc1, 0x0f, 0xba,
+	0x47, 0x14, 0xb6, 0xa7, 0x9d, 0xa5, 0x08, 0xf2, 0x04, 0xc0, 0x49, 0xc4, 0xc7, 0x66, 0x19, 0xf5,
+	0xea, 0xaf, 0xeb, 0x95, 0x69, 0xc0, 0x72, 0x68, 0xfa, 0x2f, 0x03, 0xda, 0xfb, 0x57, 0xb6, 0xef,
+	0x73, 0x0f, 0xbd, 0x2c, 0xb5, 0xe3, 0x61, 0x90, 0x6a, 0xc7, 0xc3, 0x40, 0x9e, 0x8a, 0xad, 0x5b,
+	0xe2, 0x41, 0x24, 0x56, 0x5a, 0xab, 0xca, 0x5a, 0x6b, 0xf5, 0x10, 0xba, 0xd8, 0x95, 0x4e, 0x43,
+	0x5b, 0x08, 0x1e, 0xf9, 0xe8, 0xc5, 0x16, 0xeb, 0x20, 0xf3, 0x54, 0xf1, 0xb0, 0x12, 0xaa, 0x93,
+	0x31, 0x39, 0x5a, 0x2c, 0x21, 0x6f, 0x07, 0x41, 0xbd, 0x20, 0x08, 0x9e, 0x41, 0x57, 0x2b, 0xfe,
+	0x0a, 0x9e, 0xc9, 0x99, 0x98, 0x78, 0xe6, 0x4b, 0x80, 0xe7, 0x0b, 0xd7, 0x9b, 0xe1, 0x5b, 0x59,
+	0x68, 0xf7, 0x03, 0x80, 0x0b, 0xd7, 0xe3, 0xd3, 0xec, 0x35, 0x6b, 0xb2, 0x96, 0xe4, 0xe0, 0x2b,
+	0x46, 0x9f, 0x42, 0x1b, 0x05, 0xe8, 0xe3, 0x3f, 0x82, 0x9a, 0xdc, 0x95, 0x1c, 0xbf, 0xd2, 0xfc,
+	0x64, 0x07, 0x31, 0x05, 0xa2, 0x1f, 0xc3, 0x1b, 0xe3, 0x40, 0xb8, 0x17, 0xae, 0x63, 0xcb, 0x7e,
+	0x53, 0xb5, 0x1f, 0x26, 0x34, 0xc4, 0x55, 0xc4, 0xed, 0x99, 0x12, 0xd2, 0x62, 0x09, 0x49, 0xff,
+	0x66, 0x40, 0x1b, 0x8b, 0xc2, 0x69, 0xe0, 0xb9, 0xce, 0x72, 0x93, 0xba, 0xaa, 0x80, 0xcd, 0xec,
+	0x65, 0xac, 0x23, 0xa9, 0x85, 0x9c, 0x03, 0x7b, 0x19, 0xcb, 0x65, 0xc7, 0x0b, 0x62, 0xbd, 0xac,
+	0xb2, 0xae, 0x85, 0x1c, 0x5c, 0x7e, 0x08, 0x5d, 0xfe, 0x23, 0x9f, 0x87, 0x62, 0xaa, 0x9b, 0xf6,
+	0x2a, 0x6a, 0xd0, 0x51, 0xcc, 0x11, 0xf2, 0xc8, 0xfb, 0xa0, 0xaa, 0x9c, 0xc2, 0x68, 0xb7, 0xa9,
+	0x53, 0x11, 0x41, 0x9f, 0x6b, 0x35, 0xf5, 0x9d, 0x7c, 0x0a, 0xcd, 0x50, 0x2a, 0xec, 0x6e, 0xc8,
+	0x97, 0xcc, 0x22, 0x96, 0x02, 0xe9, 0x9f, 0x13, 0x5b, 0x07, 0xd8, 0x8a, 0x67, 0xf5, 0xc4, 0x78,
+	0x49, 0x3d, 0xf9, 0x0c, 0xea, 0xaa, 0x7b, 0x37, 0xcb, 0x05, 0x2f, 0x54, 0x26, 0x71, 0x4f, 0xfd,
+	0xc7, 0x34, 0x9a, 0x7e, 0x08, 0x75, 0x7d, 0x54, 0x13, 0xaa, 0xc7, 0x03, 0xf6, 0x8d, 0x7a, 0x4f,
+	0xb0, 0x8e, 0xf4, 0x0c, 0x59, 0x52, 0xbe, 0x1b, 0x23, 0xbb, 0x4c, 0xbf, 0xd2, 0xaa, 0x31, 0x1e,
+	0x06, 0x91, 0x20, 0x3f, 0x87, 0x46, 0x32, 0x40, 0x6c, 0x32, 0x4f, 0x9f, 0x95, 0xe0, 0xe8, 0x3f,
+	0x0c, 0xa8, 0x4c, 0x46, 0x83, 0x2c, 0xa9, 0x8c, 0x7c, 0x52, 0x25, 0x7e, 0x2d, 0xaf, 0xa6, 0xdf,
+	0x55, 0xb0, 0x88, 0x12, 0x9f, 0x29, 0x82, 0x7c, 0x08, 0x3d, 0x1e, 0x3b, 0xb6, 0x87, 0xe1, 0xa3,
+	0xfd, 0xa1, 0xb2, 0x6c, 0x3b, 0xe3, 0xa3, 0x53, 0xc8, 0x07, 0xb0, 0x15, 0x46, 0x6e, 0x10, 0xb9,
+	0x62, 0x39, 0x3d, 0x0f, 0xe4, 0xf4, 0x50, 0x43, 0x49, 0xdd, 0x84, 0xfb, 0x3c, 0xd0, 0x63, 0x44,
+	0x92, 0x8f, 0xf5, 0x95, 0x7c, 0xa4, 0x9f, 0x40, 0x6b, 0x32, 0x1a, 0x68, 0x9f, 0x3e, 0x84, 0x6a,
+	0xec, 0xd9, 0x89, 0xc1, 0xdb, 0x2b, 0x06, 0x8f, 0x06, 0x0c, 0x17, 0xe9, 0xdf, 0x0d, 0xe8, 0x9c,
+	0x45, 0xb6, 0x73, 0xcd, 0x67, 0x6a, 0x68, 0x5d, 0x9b, 0x6c, 0x8c, 0xdb, 0x93, 0x4d, 0x6e, 0x58,
+	0x2d, 0x6f, 0x1a, 0x56, 0x2b, 0x2b, 0xc3, 0xea, 0xfb, 0xd0, 0x8e, 0x78, 0x1c, 0x78, 0x37, 0x6a,
+	0xf8, 0x53, 0xf5, 0x17, 0x12, 0xd6, 0x00, 0x3b, 0xea, 0xef, 0x17, 0x2e, 0x17, 0xd3, 0x90, 0x47,
+	0x6e, 0xa0, 0x6a, 0x70, 0x85, 0xb5, 0x91, 0x77, 0x8a, 0x2c, 0x3a, 0x80, 0x6e, 0x5e, 0xcf, 0x98,
+	0x7c, 0xb2, 0xd6, 0x2d, 0x99, 0x79, 0x03, 0xf3, 0xd0, 0xb4, 0x69, 0x0a, 0x64, 0x59, 0xc5, 0x33,
+	0x93, 0xf2, 0xfb, 0x5a, 0xc6, 0xae, 0x4d, 0xe6, 0xeb, 0x3a, 0x97, 0x8b, 0x74, 0xde, 0x4e, 0x0f,
+	0xd4, 0x85, 0x7c, 0x6f, 0x35, 0x47, 0x36, 0x2b, 0xad, 0x60, 0xf4, 0x6b, 0x68, 0x9e, 0xf1, 0x79,
+	0xe8, 0xc9, 0x57, 0xa7, 0x68, 0x4a, 0x49, 0xbf, 0x31, 0x94, 0x8b, 0xbe, 0x31, 0x54, 0xb2, 0x6f,
+	0x0c, 0x74, 0x09, 0x35, 0x16, 0x2c, 0xd4, 0xe3, 0x95, 0x98, 0x64, 0xac, 0x9a, 0xd4, 0x87, 0xa6,
+	0xd0, 0x87, 0x69, 0x79, 0x29, 0x4d, 0xde, 0x03, 0x88, 0xf8, 0x2c, 0x49, 0xa2, 0x0a, 0xbe, 0x39,
+	0x39, 0x8e, 0xdc, 0x1b, 0xdb, 0xbe, 0x2b, 0xdc, 0x98, 0xeb, 0x56, 0x20, 0xa5, 0xe9, 0x1c, 0x3a,
+	0x87, 0xae, 0x97, 0x95, 0xe6, 0x47, 0xd0, 0x4a, 0xe4, 0x26, 0xde, 0xbb, 0xb7, 0x72, 0x11, 0x7a,
+	0x91, 0x65, 0x30, 0xd9, 0x1c, 0x47, 0x52, 0xfd, 0xa4, 0x6e, 0xae, 0xbc, 0x2e, 0x68, 0x18, 0xd3,
+	0x00, 0xfa, 0x4f, 0x03, 0x60, 0xb0, 0x98, 0xb9, 0x42, 0x35, 0xc7, 0xd9, 0xe0, 0x6a, 0xe4, 0x07,
+	0x57, 0xd9, 0xaa, 0x47, 0xa1, 0xa3, 0x0d, 0x95, 0x3f, 0xd3, 0xa4, 0xae, 0xe4, 0x92, 0x7a, 0xd3,
+	0x07, 0x18, 0x13, 0x1a, 0xc1, 0x42, 0x38, 0xc1, 0x9c, 0x27, 0x45, 0x51, 0x93, 0xab, 0xbd, 0x66,
+	0x7d, 0xbd, 0xd7, 0xbc, 0x0f, 0x75, 0x61, 0x47, 0x97, 0x5c, 0x98, 0x0d, 0xa5, 0x8d, 0xa2, 0xe8,
+	0x13, 0x68, 0xa2, 0xce, 0xb2, 0xc7, 0xdc, 0x83, 0x3a, 0x36, 0xb4, 0x85, 0x25, 0x2a, 0xb3, 0x8c,
+	0x69, 0x14, 0xfd, 0x8b, 0x01, 0x1d, 0x64, 0x27, 0x71, 0xfd, 0xbf, 0x99, 0x9c, 0x36, 0xa5, 0xd5,
+	0x5c, 0x53, 0x2a, 0xb9, 0x0b, 0x5f, 0xb8, 0x9e, 0x4e, 0x4e, 0x45, 0xc8, 0x6a, 0x74, 0x61, 0xbb,
+	0xde, 0x22, 0xe2, 0xf1, 0x34, 0xf0, 0xbd, 0xe4, 0xd3, 0x52, 0x27, 0x61, 0x9e, 0xf8, 0xde, 0x92,
+	0x7e, 0x09, 0x5d, 0xad, 0x5e, 0x9a, 0x05, 0xaf, 0x67, 0xe0, 0x18, 0xe0, 0x94, 0x47, 0x73, 0x37,
+	0xc6, 0x8f, 0x25, 0x9b, 0xac, 0xbb, 0x97, 0x14, 0xf6, 0x32, 0x46, 0xa7, 0x22, 0xd0, 0xc2, 0xd0,
+	0x49, 0x42, 0x16, 0x7f, 0xd3, 0x43, 0x19, 0x20, 0xe2, 0x4a, 0xd7, 0xe8, 0xc7, 0xd0, 0x0e, 0x53,
+	0xe9, 0x85, 0x2a, 0x65, 0x87, 0xb3, 0x3c, 0x94, 0x8e, 0xa1, 0xf6, 0xed, 0x22, 0x10, 0x76, 0xc1,
+	0xd8, 0xf7, 0x00, 0x20, 0xe4, 0xd1, 0x74, 0xee, 0xfa, 0x0b, 0x9d, 0x4d, 0x06, 0x6b, 0x85, 0x3c,
+	0x3a, 0x46, 0x86, 0xd4, 0xf5, 0x7c, 0x11, 0xc5, 0x49, 0x57, 0xad, 0x08, 0xca, 0xa1, 0x8d, 0xf2,
+	0xd2, 0x46, 0xa9, 0xa9, 0x53, 0xb3, 0x70, 0x24, 0x44, 0x28, 0x4b, 0x21, 0xb2, 0xfe, 0x66, 0xf6,
+	0x17, 0x62, 0x75, 0x4f, 0xf3, 0x27, 0x03, 0x00, 0x19, 0x87, 0xae, 0xbc, 0xb7, 0x4d, 0xf7, 0x59,
+	0x54, 0xe3, 0xde, 0x03, 0x88, 0x17, 0x61, 0x18, 0xa9, 0x59, 0x49, 0x29, 0x9f, 0xe3, 0xa8, 0x6f,
+	0x89, 0x82, 0xc7, 0x42, 0xd7, 0x38, 0x4d, 0xc9, 0xeb, 0xc0, 0x2f, 0x02, 0xb2, 0x2b, 0x9b, 0xe9,
+	0xb2, 0x86, 0xdf, 0x08, 0xa4, 0x0a, 0x33, 0xfa, 0x85, 0x56, 0x48, 0xb5, 0x57, 0x7b, 0x50, 0x97,
+	0xb8, 0xa8, 0xd0, 0x17, 0x99, 0xe2, 0x4c, 0xa3, 0x1e, 0xfd, 0xbe, 0x03, 0xf5, 0x21, 0x22, 0xc8,
+	0x23, 0x68, 0x0e, 0x66, 0xba, 0x94, 0xdd, 0x6e, 0x40, 0xfa, 0xb7, 0x59, 0xb4, 0x44, 0x3e, 0x86,
+	0xca, 0x90, 0x8b, 0x57, 0x86, 0xef, 0x43, 0x1b, 0x3b, 0x44, 0x5d, 0x87, 0x56, 0x9e, 0xf0, 0xfc,
+	0x3c, 0xdf, 0xbf, 0x5f, 0x3c, 0x87, 0xd3, 0xd2, 0x27, 0x06, 0x19, 0x40, 0x5d, 0x8d, 0x78, 0xe4,
+	0xed, 0x3c, 0x6a, 0x65, 0x3a, 0xec, 0xf7, 0x8b, 0x96, 0x54, 0x0a, 0xd1, 0x12, 0xf9, 0x02, 0x60,
+	0xc8, 0x85, 0x1e, 0x65, 0x56, 0xb5, 0xc7, 0x2f, 0xd1, 0xfd, 0xb7, 0x0b, 0x46, 0x1e, 0x15, 0x59,
+	0xb4, 0x44, 0x0e, 0x00, 0x26, 0xd9, 0xee, 0xcd, 0xd0, 0xbb, 0xa5, 0x7c, 0x09, 0x1d, 0xeb, 0xc7,
+	0xd0, 0xb3, 0x5d, 0x5f, 0xae, 0x14, 0x5e, 0xf9, 0xed, 0x01, 0x27, 0x1d, 0x93, 0x68, 0x89, 0xfc,
+	0x0a, 0xda, 0x43, 0x2e, 0x74, 0xd7, 0x1f, 0xbf, 0xd4, 0x8a, 0x95, 0x41, 0x82, 0x96, 0x88, 0x05,
+	0xed, 0x49, 0x6e, 0xfb, 0x66, 0xec, 0xdd, 0x62, 0x3e, 0x87, 0xd6, 0x90, 0x0b, 0x6c, 0xfe, 0x0b,
+	0x75, 0x78, 0xeb, 0xd6, 0x8c, 0x90, 0xbb, 0x81, 0xd6, 0x24, 0xdd, 0xba, 0x09, 0x77, 0x97, 0x80,
+	0xaf, 0xa0, 0x27, 0xdd, 0x98, 0x76, 0xd8, 0x2e, 0x7f, 0xb9, 0x0a, 0xb9, 0xd6, 0x9d, 0x96, 0xc8,
+	0x21, 0xf4, 0x26, 0xeb, 0x12, 0x36, 0xc1, 0xef, 0x92, 0xf3, 0x0c, 0xb6, 0x12, 0x4d, 0x74, 0xdb,
+	0xfc, 0x4a, 0x7a, 0x28, 0x2c, 0x2d, 0x91, 0x5f, 0x42, 0x43, 0xee, 0x1f, 0x0d, 0x0a, 0x0d, 0x78,
+	0x73, 0xad, 0x01, 0xcd, 0x5d, 0x7e, 0x63, 0xa2, 0xb7, 0x15, 0x63, 0x36, 0x6f, 0x3d, 0x82, 0x8e,
+	0x6e, 0xb0, 0x54, 0xc6, 0xaf, 0x25, 0x4c, 0xbe, 0xd7, 0xeb, 0xbf, 0x53, 0xb8, 0x96, 0x66, 0xd3,
+	0x13, 0x0c, 0x01, 0xd5, 0xa6, 0x14, 0xa9, 0xbf, 0x92, 0xe6, 0xf9, 0x6e, 0x86, 0x96, 0xc8, 0x00,
+	0x63, 0x40, 0xef, 0xdd, 0x08, 0xbc, 0x53, 0xc4, 0xd7, 0xb0, 0x2d, 0xbf, 0xcf, 0x66, 0xb5, 0x6f,
+	0xed, 0x61, 0xc9, 0x97, 0xf7, 0xfe, 0xdb, 0x05, 0x2b, 0xb9, 0x67, 0xa1, 0x3b, 0xe4, 0x22, 0x57,
+	0xde, 0x0a, 0x8c, 0x59, 0xab, 0xb7, 0x09, 0x14, 0x4d, 0xe9, 0x4e, 0x56, 0x76, 0x6f, 0x80, 0xde,
+	0x21, 0x42, 0x25, 0x13, 0x3e, 0xd3, 0x2f, 0x8f, 0xe4, 0x5c, 0xb9, 0x4b, 0x93, 0x49, 0x6f, 0xdd,
+	0x84, 0xbb, 0x43, 0xc0, 0x79, 0x1d, 0xff, 0x6e, 0xf7, 0xe9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x03, 0x00, 0x25, 0x18, 0x99, 0xc2, 0xcb, 0x1b, 0x00, 0x00,
 }